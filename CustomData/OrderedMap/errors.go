@@ -1,14 +1,34 @@
 package OrderedMap
 
+import (
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
 // ErrKeyNotFound is an error type that represents a key not found error.
 type ErrKeyNotFound struct{}
 
-// Error returns the error message: "key %q not found".
+// Error implements the error interface.
 //
-// Returns:
-//   - string: The error message.
+// Message: "key not found"
+//
+// The message can be changed with errors.SetMessage(errors.KeyKeyNotFound, ...).
 func (e *ErrKeyNotFound) Error() string {
-	return "key not found"
+	return ers.FormatMessage(ers.KeyKeyNotFound)
+}
+
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - errors.MessageKey: The key of the message.
+func (e *ErrKeyNotFound) MessageKey() ers.MessageKey {
+	return ers.KeyKeyNotFound
+}
+
+// Is implements the errors.Is interface.
+//
+// An ErrKeyNotFound is also an errors.ErrNotFound.
+func (e *ErrKeyNotFound) Is(target error) bool {
+	return target == ers.ErrNotFound
 }
 
 // NewErrKeyNotFound creates a new ErrKeyNotFound.
@@ -243,7 +243,7 @@ func (s *LessMap[K, V]) GetAt(index int) (V, error) {
 	if index < 0 || index >= len(s.keys) {
 		return *new(V), uc.NewErrInvalidParameter(
 			"index",
-			uc.NewErrOutOfBounds(index, 0, len(s.keys)),
+			ers.NewErrOutOfBound(index, 0, len(s.keys)),
 		)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"strings"
 
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 	uc "github.com/PlayerR9/lib_units/common"
 	lup "github.com/PlayerR9/lib_units/pair"
 	lustr "github.com/PlayerR9/lib_units/strings"
@@ -315,7 +316,7 @@ func (s *OrderedMap[K, V]) GetAt(index int) (V, error) {
 	if index < 0 || index >= len(s.keys) {
 		return *new(V), uc.NewErrInvalidParameter(
 			"index",
-			uc.NewErrOutOfBounds(index, 0, len(s.keys)),
+			ers.NewErrOutOfBound(index, 0, len(s.keys)),
 		)
 	}
 
@@ -1,12 +1,37 @@
 package Sets
 
-/*
-// EqualSet is a set that uses the Equals method to compare elements.
-type EqualSet[T uc.Objecter] struct {
+import (
+	"slices"
+	"strings"
+
+	gen "github.com/PlayerR9/MyGoLib/Utility/General"
+	uc "github.com/PlayerR9/lib_units/common"
+	lustr "github.com/PlayerR9/lib_units/strings"
+)
+
+// EqualSet is a set that compares elements with General.EqualsOf; so it
+// works with elements that are not comparable (e.g., slices) and with
+// elements that implement General.Equaler.
+//
+// Operations take O(n) time, as elements cannot be hashed.
+type EqualSet[T any] struct {
 	// elems is the slice of elements in the set.
 	elems []T
 }
 
+// index returns the index of an element in the set.
+//
+// Parameters:
+//   - elem: The element to find.
+//
+// Returns:
+//   - int: The index of the element. -1 if not found.
+func (s *EqualSet[T]) index(elem T) int {
+	return slices.IndexFunc(s.elems, func(e T) bool {
+		return gen.EqualsOf(e, elem)
+	})
+}
+
 // IsEmpty checks if the set is empty.
 //
 // Returns:
@@ -31,17 +56,7 @@ func (s *EqualSet[T]) Size() int {
 // Returns:
 //   - bool: True if the set has the element, false otherwise.
 func (s *EqualSet[T]) HasElem(elem T) bool {
-	if len(s.elems) == 0 {
-		return false
-	}
-
-	for _, e := range s.elems {
-		if e.Equals(elem) {
-			return true
-		}
-	}
-
-	return false
+	return s.index(elem) != -1
 }
 
 // Add adds an element to the set.
@@ -52,10 +67,8 @@ func (s *EqualSet[T]) HasElem(elem T) bool {
 // Behaviors:
 //   - If the element is already in the set, the function does nothing.
 func (s *EqualSet[T]) Add(elem T) {
-	for _, e := range s.elems {
-		if e.Equals(elem) {
-			return
-		}
+	if s.HasElem(elem) {
+		return
 	}
 
 	s.elems = append(s.elems, elem)
@@ -69,16 +82,12 @@ func (s *EqualSet[T]) Add(elem T) {
 // Behaviors:
 //   - If the element is not in the set, the function does nothing.
 func (s *EqualSet[T]) Remove(elem T) {
-	if len(s.elems) == 0 {
+	idx := s.index(elem)
+	if idx == -1 {
 		return
 	}
 
-	indexOf := us.FindEquals(s.elems, elem)
-	if indexOf == -1 {
-		return
-	}
-
-	s.elems = append(s.elems[:indexOf], s.elems[indexOf+1:]...)
+	s.elems = slices.Delete(s.elems, idx, idx+1)
 }
 
 // Union returns the union of the set with another set.
@@ -89,32 +98,17 @@ func (s *EqualSet[T]) Remove(elem T) {
 // Returns:
 //   - *EqualSet[T]: The union of the set with the other set.
 func (s *EqualSet[T]) Union(other *EqualSet[T]) *EqualSet[T] {
+	union := s.Copy()
+
 	if other == nil {
-		return s
+		return union
 	}
 
-	elems := make([]T, len(s.elems))
-	copy(elems, s.elems)
-	limit := len(elems)
-
 	for _, e := range other.elems {
-		found := false
-
-		for i := 0; i < limit; i++ {
-			if elems[i].Equals(e) {
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			elems = append(elems, e)
-		}
+		union.Add(e)
 	}
 
-	return &EqualSet[T]{
-		elems: elems,
-	}
+	return union
 }
 
 // Intersection returns the intersection of the set with another set.
@@ -125,23 +119,19 @@ func (s *EqualSet[T]) Union(other *EqualSet[T]) *EqualSet[T] {
 // Returns:
 //   - *EqualSet[T]: The intersection of the set with the other set.
 func (s *EqualSet[T]) Intersection(other *EqualSet[T]) *EqualSet[T] {
+	intersection := new(EqualSet[T])
+
 	if other == nil {
-		return &EqualSet[T]{
-			elems: make([]T, 0),
-		}
+		return intersection
 	}
 
-	newElems := make([]T, 0)
-
 	for _, e := range s.elems {
 		if other.HasElem(e) {
-			newElems = append(newElems, e)
+			intersection.elems = append(intersection.elems, e)
 		}
 	}
 
-	return &EqualSet[T]{
-		elems: newElems,
-	}
+	return intersection
 }
 
 // Difference returns the difference of the set with another set.
@@ -153,20 +143,18 @@ func (s *EqualSet[T]) Intersection(other *EqualSet[T]) *EqualSet[T] {
 //   - *EqualSet[T]: The difference of the set with the other set.
 func (s *EqualSet[T]) Difference(other *EqualSet[T]) *EqualSet[T] {
 	if other == nil {
-		return s
+		return s.Copy()
 	}
 
-	newElems := make([]T, 0)
+	difference := new(EqualSet[T])
 
 	for _, e := range s.elems {
 		if !other.HasElem(e) {
-			newElems = append(newElems, e)
+			difference.elems = append(difference.elems, e)
 		}
 	}
 
-	return &EqualSet[T]{
-		elems: newElems,
-	}
+	return difference
 }
 
 // SymmetricDifference returns the symmetric difference of the set with another set.
@@ -178,28 +166,13 @@ func (s *EqualSet[T]) Difference(other *EqualSet[T]) *EqualSet[T] {
 //   - *EqualSet[T]: The symmetric difference of the set with the other set.
 func (s *EqualSet[T]) SymmetricDifference(other *EqualSet[T]) *EqualSet[T] {
 	if other == nil {
-		return s
+		return s.Copy()
 	}
 
-	diff1 := make([]T, 0)
+	difference := s.Difference(other)
+	difference.elems = append(difference.elems, other.Difference(s).elems...)
 
-	for _, e := range s.elems {
-		if !other.HasElem(e) {
-			diff1 = append(diff1, e)
-		}
-	}
-
-	diff2 := make([]T, 0)
-
-	for _, e := range other.elems {
-		if !s.HasElem(e) {
-			diff2 = append(diff2, e)
-		}
-	}
-
-	return &EqualSet[T]{
-		elems: us.MergeUniqueEquals(diff1, diff2),
-	}
+	return difference
 }
 
 // IsSubset checks if the set is a subset of another set.
@@ -225,9 +198,7 @@ func (s *EqualSet[T]) IsSubset(other *EqualSet[T]) bool {
 
 // Clear removes all elements from the set.
 func (s *EqualSet[T]) Clear() {
-	for i := 0; i < len(s.elems); i++ {
-		s.elems[i] = *new(T)
-	}
+	clear(s.elems)
 
 	s.elems = s.elems[:0]
 }
@@ -258,55 +229,35 @@ func (s *EqualSet[T]) String() string {
 	return builder.String()
 }
 
-// Equals checks if the set is equal to another set.
-//
-// Parameters:
-//   - other: The other set to compare.
+// Equals implements the General.Equaler interface.
 //
-// Returns:
-//   - bool: True if the sets are equal, false otherwise.
-func (s *EqualSet[T]) Equals(other uc.Equaler) bool {
-	if other == nil {
-		return false
-	}
-
+// Two sets are equal if they hold the same elements, in any order. Thus,
+// sets of sets are compared as expected.
+func (s *EqualSet[T]) Equals(other any) bool {
 	otherEs, ok := other.(*EqualSet[T])
-	if !ok {
+	if !ok || otherEs == nil {
 		return false
 	}
 
-	if len(s.elems) != len(otherEs.elems) {
-		return false
-	}
-
-	for _, k := range s.elems {
-		if !otherEs.HasElem(k) {
-			return false
-		}
-	}
-
-	return true
+	return len(s.elems) == len(otherEs.elems) && s.IsSubset(otherEs)
 }
 
 // Copy returns a copy of the set.
 //
 // Returns:
 //   - *EqualSet[T]: A copy of the set.
-func (s *EqualSet[T]) Copy() uc.Copier {
-	newElems := make([]T, len(s.elems))
-	copy(newElems, s.elems)
-
+func (s *EqualSet[T]) Copy() *EqualSet[T] {
 	return &EqualSet[T]{
-		elems: newElems,
+		elems: slices.Clone(s.elems),
 	}
 }
 
-// slice returns a slice of the elements in the set.
+// Slice returns a slice of the elements in the set.
 //
 // Returns:
-//   - []T: A slice of the elements in the set.
+//   - []T: A copy of the elements in the set, in insertion order.
 func (s *EqualSet[T]) Slice() []T {
-	return s.elems
+	return slices.Clone(s.elems)
 }
 
 // Iterator returns an iterator for the set.
@@ -314,16 +265,25 @@ func (s *EqualSet[T]) Slice() []T {
 // Returns:
 //   - uc.Iterater[T]: An iterator for the set.
 func (s *EqualSet[T]) Iterator() uc.Iterater[T] {
-	return uc.NewSimpleIterator(s.elems)
+	return uc.NewSimpleIterator(s.Slice())
 }
 
 // NewEqualSet creates a new EqualSet.
 //
+// Parameters:
+//   - elems: The elements to add to the set.
+//
 // Returns:
-//   - *EqualSet: A new EqualSet.
-func NewEqualSet[T uc.Objecter](elems []T) *EqualSet[T] {
-	return &EqualSet[T]{
-		elems: us.UniquefyEquals(elems, true),
+//   - *EqualSet[T]: A new EqualSet.
+//
+// Behaviors:
+//   - It ignores duplicate elements, keeping the first one.
+func NewEqualSet[T any](elems []T) *EqualSet[T] {
+	set := new(EqualSet[T])
+
+	for _, elem := range elems {
+		set.Add(elem)
 	}
+
+	return set
 }
-*/
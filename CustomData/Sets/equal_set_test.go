@@ -0,0 +1,64 @@
+package Sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEqualSet(t *testing.T) {
+	a := NewEqualSet([][]int{{1}, {2, 3}, {1}})
+	b := NewEqualSet([][]int{{2, 3}, {4}})
+
+	if a.Size() != 2 {
+		t.Errorf("NewEqualSet failed: expected %d, got %d", 2, a.Size())
+	}
+
+	if !a.HasElem([]int{2, 3}) {
+		t.Errorf("HasElem failed: expected %v, got %v", true, false)
+	}
+
+	tests := []struct {
+		name     string
+		set      *EqualSet[[]int]
+		expected [][]int
+	}{
+		{"Union", a.Union(b), [][]int{{1}, {2, 3}, {4}}},
+		{"Intersection", a.Intersection(b), [][]int{{2, 3}}},
+		{"Difference", a.Difference(b), [][]int{{1}}},
+		{"SymmetricDifference", a.SymmetricDifference(b), [][]int{{1}, {4}}},
+	}
+
+	for _, test := range tests {
+		if got := test.set.Slice(); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s failed: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+
+	a.Remove([]int{1})
+
+	if !a.IsSubset(b) {
+		t.Errorf("IsSubset failed: expected %v, got %v", true, false)
+	}
+}
+
+func TestEqualSetEquals(t *testing.T) {
+	a := NewEqualSet([]int{1, 2, 3})
+	b := NewEqualSet([]int{3, 1, 2})
+
+	if !a.Equals(b) {
+		t.Errorf("Equals failed: expected %v, got %v", true, false)
+	}
+
+	b.Remove(3)
+
+	if a.Equals(b) {
+		t.Errorf("Equals failed: expected %v, got %v", false, true)
+	}
+
+	// Sets of sets use Equals, not the order of the elements.
+	nested := NewEqualSet([]*EqualSet[int]{a, NewEqualSet([]int{2, 1, 3})})
+
+	if nested.Size() != 1 {
+		t.Errorf("NewEqualSet failed: expected %d, got %d", 1, nested.Size())
+	}
+}
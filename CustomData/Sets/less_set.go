@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	uts "github.com/PlayerR9/MyGoLib/Utility/Sorting"
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 	uc "github.com/PlayerR9/lib_units/common"
 	"golang.org/x/exp/slices"
 
@@ -368,6 +369,24 @@ func NewLessSet[T any](elems []T, sf uts.SortFunc[T]) *LessSet[T] {
 //   - int: The index of the element in the set.
 //   - bool: True if the element is in the set, false otherwise.
 func (s *LessSet[T]) Find(elem T) (int, bool) {
+	pos, err := s.FindErr(elem)
+	return pos, err == nil
+}
+
+// FindErr is like Find but reports a missing element as an error.
+//
+// Parameters:
+//   - elem: The element to find.
+//
+// Returns:
+//   - int: The index of the element in the set; or, if it is missing, the
+//     index where it would be inserted.
+//   - error: errors.ErrNotFound if the element is not in the set.
+func (s *LessSet[T]) FindErr(elem T) (int, error) {
 	pos, ok := slices.BinarySearchFunc(s.elems, elem, s.sf)
-	return pos, ok
+	if !ok {
+		return pos, ers.ErrNotFound
+	}
+
+	return pos, nil
 }
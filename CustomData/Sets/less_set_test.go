@@ -0,0 +1,31 @@
+package Sets
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+func TestLessSetFindErr(t *testing.T) {
+	set := NewLessSet([]int{5, 1, 3}, cmp.Compare[int])
+
+	pos, err := set.FindErr(3)
+	if err != nil {
+		t.Fatalf("FindErr failed: %s", err.Error())
+	}
+
+	if pos != 1 {
+		t.Errorf("FindErr failed: expected %d, got %d", 1, pos)
+	}
+
+	pos, err = set.FindErr(4)
+	if !errors.Is(err, ers.ErrNotFound) {
+		t.Errorf("FindErr failed: expected %v, got %v", ers.ErrNotFound, err)
+	}
+
+	if pos != 2 {
+		t.Errorf("FindErr failed: expected %d, got %d", 2, pos)
+	}
+}
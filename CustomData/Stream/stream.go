@@ -1,6 +1,9 @@
 package Stream
 
 import (
+	"math"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 	uc "github.com/PlayerR9/lib_units/common"
 )
 
@@ -76,9 +79,9 @@ func (s *Stream[T]) IsEmpty() bool {
 //   - Use qty -1 to get all items from 'from' to the end of the stream.
 func (s *Stream[T]) Get(from int, qty int) ([]T, error) {
 	if from < 0 {
-		return nil, uc.NewErrInvalidParameter("from", uc.NewErrGTE(0))
+		return nil, uc.NewErrInvalidParameter("from", ers.NewErrOutOfBound(from, 0, math.MaxInt))
 	} else if qty < -1 {
-		return nil, uc.NewErrInvalidParameter("qty", uc.NewErrGTE(-1))
+		return nil, uc.NewErrInvalidParameter("qty", ers.NewErrOutOfBound(qty, -1, math.MaxInt))
 	}
 
 	if qty == 0 {
@@ -103,12 +106,8 @@ func (s *Stream[T]) Get(from int, qty int) ([]T, error) {
 //   - T: The item at the given index.
 //   - error: An error if the index is negative or out of bounds.
 func (s *Stream[T]) GetOne(index int) (T, error) {
-	if index < 0 {
-		return *new(T), uc.NewErrInvalidParameter("index", uc.NewErrGTE(0))
-	}
-
-	if index >= s.size {
-		return *new(T), uc.NewErrInvalidParameter("index", uc.NewErrLT(s.size))
+	if index < 0 || index >= s.size {
+		return *new(T), uc.NewErrInvalidParameter("index", ers.NewErrOutOfBound(index, 0, s.size))
 	}
 
 	return s.items[index], nil
@@ -0,0 +1,142 @@
+package String
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// escape_len returns the length of the ANSI escape sequence at the start of
+// a string.
+//
+// Parameters:
+//   - str: The string.
+//
+// Returns:
+//   - int: The number of bytes of the escape sequence. 0 if the string does
+//     not start with one.
+//
+// Behaviors:
+//   - CSI sequences ("\x1b[" ... final byte) and OSC sequences ("\x1b]" ...
+//     BEL or "\x1b\\") are recognized, as well as two-byte escapes.
+//   - An unterminated sequence extends to the end of the string.
+func escape_len(str string) int {
+	if len(str) < 2 || str[0] != '\x1b' {
+		return 0
+	}
+
+	switch str[1] {
+	case '[':
+		for i := 2; i < len(str); i++ {
+			if str[i] >= 0x40 && str[i] <= 0x7e {
+				return i + 1
+			}
+		}
+
+		return len(str)
+	case ']':
+		for i := 2; i < len(str); i++ {
+			if str[i] == '\a' {
+				return i + 1
+			} else if str[i] == '\x1b' && i+1 < len(str) && str[i+1] == '\\' {
+				return i + 2
+			}
+		}
+
+		return len(str)
+	default:
+		return 2
+	}
+}
+
+// VisibleWidth returns the number of columns a string takes once printed
+// on a terminal.
+//
+// Parameters:
+//   - str: The string.
+//
+// Returns:
+//   - int: The display width of the string.
+//
+// Behaviors:
+//   - ANSI escape sequences (colors, styles, hyperlinks, ...) take no
+//     column.
+//   - Wide runes (e.g., CJK) take two columns and combining marks none.
+//
+// Example:
+//
+//	fmt.Println(VisibleWidth("\x1b[31mred\x1b[0m")) // 3
+func VisibleWidth(str string) int {
+	var width int
+
+	for i := 0; i < len(str); {
+		n := escape_len(str[i:])
+		if n > 0 {
+			i += n
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+		i += size
+
+		width += runewidth.RuneWidth(r)
+	}
+
+	return width
+}
+
+// TruncateVisible truncates a string so that it takes at most width columns
+// once printed on a terminal. (see VisibleWidth)
+//
+// Parameters:
+//   - str: The string.
+//   - width: The maximum display width.
+//
+// Returns:
+//   - string: The truncated string.
+//
+// Behaviors:
+//   - Escape sequences are kept, even after the cut; so that a style reset
+//     at the end of the string still applies.
+//   - A wide rune that does not fit entirely is dropped.
+//   - If width is not positive, only the escape sequences are kept.
+//
+// Example:
+//
+//	fmt.Printf("%q\n", TruncateVisible("\x1b[31mhello\x1b[0m", 2)) // "\x1b[31mhe\x1b[0m"
+func TruncateVisible(str string, width int) string {
+	if VisibleWidth(str) <= width {
+		return str
+	}
+
+	var builder strings.Builder
+
+	var used int
+
+	var cut bool
+
+	for i := 0; i < len(str); {
+		n := escape_len(str[i:])
+		if n > 0 {
+			builder.WriteString(str[i : i+n])
+			i += n
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+
+		w := runewidth.RuneWidth(r)
+		if !cut && used+w <= width {
+			builder.WriteString(str[i : i+size])
+			used += w
+		} else {
+			cut = true
+		}
+
+		i += size
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,52 @@
+package String
+
+import (
+	"testing"
+)
+
+func TestVisibleWidth(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected int
+	}{
+		{"hello", 5},
+		{"\x1b[31mred\x1b[0m", 3},
+		{"\x1b[1;38;5;208mbold\x1b[m", 4},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", 4},
+		{"日本", 4},
+		{"é", 1},
+		{"\x1b[", 0},
+	}
+
+	for _, test := range tests {
+		if got := VisibleWidth(test.str); got != test.expected {
+			t.Errorf("VisibleWidth(%q) failed: expected %d, got %d", test.str, test.expected, got)
+		}
+	}
+}
+
+func TestTruncateVisible(t *testing.T) {
+	tests := []struct {
+		str      string
+		width    int
+		expected string
+	}{
+		{"hello", 10, "hello"},
+		{"\x1b[31mhello\x1b[0m", 2, "\x1b[31mhe\x1b[0m"},
+		{"日本語", 3, "日"},
+		{"日a", 1, ""},
+		{"éx", 1, "é"},
+		{"\x1b[31mred\x1b[0m", 0, "\x1b[31m\x1b[0m"},
+	}
+
+	for _, test := range tests {
+		got := TruncateVisible(test.str, test.width)
+		if got != test.expected {
+			t.Errorf("TruncateVisible(%q, %d) failed: expected %q, got %q", test.str, test.width, test.expected, got)
+		}
+
+		if VisibleWidth(got) > max(test.width, 0) {
+			t.Errorf("TruncateVisible(%q, %d) failed: %q is too wide", test.str, test.width, got)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package String
+
+import (
+	"github.com/rivo/uniseg"
+)
+
+// grapheme is a grapheme cluster of a string.
+type grapheme struct {
+	// start is the byte offset of the cluster.
+	start int
+
+	// width is the display width of the cluster.
+	width int
+}
+
+// graphemes splits a string into grapheme clusters; that is, the units a
+// reader perceives as single characters. (e.g., "e" followed by a combining
+// accent, or a flag emoji)
+//
+// Parameters:
+//   - str: The string.
+//
+// Returns:
+//   - []grapheme: The clusters, in order.
+func graphemes(str string) []grapheme {
+	var clusters []grapheme
+
+	state := -1
+	rest := str
+
+	for rest != "" {
+		start := len(str) - len(rest)
+
+		var width int
+
+		_, rest, width, state = uniseg.FirstGraphemeClusterInString(rest, state)
+
+		clusters = append(clusters, grapheme{start: start, width: width})
+	}
+
+	return clusters
+}
+
+// TrimPrefixGraphemes removes the first n grapheme clusters of a string.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of grapheme clusters to remove.
+//
+// Returns:
+//   - string: The trimmed string.
+//
+// Behaviors:
+//   - If n is not positive, the string is returned as is.
+//   - If the string has at most n grapheme clusters, an empty string is
+//     returned.
+//   - Unlike TrimPrefixRunes, a character made of several runes (e.g., a
+//     letter and its combining accent) is never split.
+func TrimPrefixGraphemes(str string, n int) string {
+	if n <= 0 {
+		return str
+	}
+
+	clusters := graphemes(str)
+	if n >= len(clusters) {
+		return ""
+	}
+
+	return str[clusters[n].start:]
+}
+
+// TrimSuffixGraphemes removes the last n grapheme clusters of a string.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of grapheme clusters to remove.
+//
+// Returns:
+//   - string: The trimmed string.
+//
+// Behaviors:
+//   - If n is not positive, the string is returned as is.
+//   - If the string has at most n grapheme clusters, an empty string is
+//     returned.
+//   - Unlike TrimSuffixRunes, a character made of several runes (e.g., a
+//     letter and its combining accent) is never split.
+func TrimSuffixGraphemes(str string, n int) string {
+	if n <= 0 {
+		return str
+	}
+
+	clusters := graphemes(str)
+	if n >= len(clusters) {
+		return ""
+	}
+
+	return str[:clusters[len(clusters)-n].start]
+}
+
+// TrimSuffixWidth removes the grapheme clusters at the end of a string that
+// are needed to free, at least, the given number of columns once printed.
+//
+// Parameters:
+//   - str: The string.
+//   - width: The number of columns to free.
+//
+// Returns:
+//   - string: The trimmed string.
+//
+// Behaviors:
+//   - Wide characters (e.g., CJK) take two columns, so more columns than
+//     asked can be freed.
+//   - If width is not positive, the string is returned as is.
+//
+// Example:
+//
+//	fmt.Println(TrimSuffixWidth("ab日本", 3)) // ab
+func TrimSuffixWidth(str string, width int) string {
+	if width <= 0 {
+		return str
+	}
+
+	clusters := graphemes(str)
+
+	end := len(str)
+
+	for i := len(clusters) - 1; i >= 0 && width > 0; i-- {
+		width -= clusters[i].width
+		end = clusters[i].start
+	}
+
+	return str[:end]
+}
+
+// TruncateWidth truncates a string so that it takes at most width columns
+// once printed, without splitting any grapheme cluster.
+//
+// Parameters:
+//   - str: The string.
+//   - width: The maximum number of columns.
+//
+// Returns:
+//   - string: The truncated string.
+//
+// Behaviors:
+//   - A wide character that does not fit entirely is dropped.
+//   - If width is not positive, an empty string is returned.
+//
+// Example:
+//
+//	fmt.Println(TruncateWidth("日本語", 5)) // 日本
+func TruncateWidth(str string, width int) string {
+	var used int
+
+	for _, cluster := range graphemes(str) {
+		used += cluster.width
+
+		if used > width {
+			return str[:cluster.start]
+		}
+	}
+
+	return str
+}
@@ -0,0 +1,34 @@
+package String
+
+import (
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	// "é" written as "e" followed by a combining acute accent.
+	decomposed := "he\u0301llo"
+
+	if got := TrimSuffixGraphemes(decomposed, 3); got != "he\u0301" {
+		t.Errorf("TrimSuffixGraphemes failed: expected %q, got %q", "he\u0301", got)
+	}
+
+	if got := TrimPrefixGraphemes(decomposed, 2); got != "llo" {
+		t.Errorf("TrimPrefixGraphemes failed: expected %q, got %q", "llo", got)
+	}
+
+	if got := TrimSuffixGraphemes(decomposed, 10); got != "" {
+		t.Errorf("TrimSuffixGraphemes failed: expected %q, got %q", "", got)
+	}
+
+	if got := TrimSuffixWidth("ab日本", 3); got != "ab" {
+		t.Errorf("TrimSuffixWidth failed: expected %q, got %q", "ab", got)
+	}
+
+	if got := TruncateWidth("日本語", 5); got != "日本" {
+		t.Errorf("TruncateWidth failed: expected %q, got %q", "日本", got)
+	}
+
+	if got := TruncateWidth(decomposed, 2); got != "he\u0301" {
+		t.Errorf("TruncateWidth failed: expected %q, got %q", "he\u0301", got)
+	}
+}
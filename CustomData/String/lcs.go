@@ -0,0 +1,210 @@
+package String
+
+// lcs_table returns the table of the lengths of the longest common
+// subsequences of the prefixes of two rune slices.
+//
+// Parameters:
+//   - a: The first rune slice.
+//   - b: The second rune slice.
+//
+// Returns:
+//   - [][]int: The table. table[i][j] is the length of the longest common
+//     subsequence of a[:i] and b[:j].
+func lcs_table(a, b []rune) [][]int {
+	table := make([][]int, len(a)+1)
+
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1] + 1
+			} else {
+				table[i][j] = max(table[i-1][j], table[i][j-1])
+			}
+		}
+	}
+
+	return table
+}
+
+// LCSLength returns the length, in runes, of the longest common subsequence
+// of two strings.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//   - int: The length of the longest common subsequence.
+//
+// Behaviors:
+//   - This takes O(len(a) * len(b)) time but only O(len(b)) memory.
+func LCSLength(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			if ra[i-1] == rb[j-1] {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = max(prev[j], curr[j-1])
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// LCS returns the longest common subsequence of two strings; that is, the
+// longest string whose runes appear, in order but not necessarily
+// contiguously, in both strings.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//   - string: The longest common subsequence.
+//
+// Behaviors:
+//   - If there are several longest common subsequences, only one of them
+//     is returned.
+//   - This takes O(len(a) * len(b)) time and memory.
+//
+// Example:
+//
+//	fmt.Println(LCS("ABCBDAB", "BDCABA")) // BCBA
+func LCS(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+
+	table := lcs_table(ra, rb)
+
+	result := make([]rune, table[len(ra)][len(rb)])
+	k := len(result)
+
+	for i, j := len(ra), len(rb); i > 0 && j > 0; {
+		if ra[i-1] == rb[j-1] {
+			k--
+			result[k] = ra[i-1]
+			i--
+			j--
+		} else if table[i-1][j] >= table[i][j-1] {
+			i--
+		} else {
+			j--
+		}
+	}
+
+	return string(result)
+}
+
+// lcsubstring returns the length and the end, in a, of the longest common
+// substring of two rune slices.
+//
+// Parameters:
+//   - a: The first rune slice.
+//   - b: The second rune slice.
+//
+// Returns:
+//   - int: The length of the longest common substring.
+//   - int: The index in a after its last rune.
+func lcsubstring(a, b []rune) (int, int) {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	var best, end int
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] != b[j-1] {
+				curr[j] = 0
+				continue
+			}
+
+			curr[j] = prev[j-1] + 1
+
+			if curr[j] > best {
+				best = curr[j]
+				end = i
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return best, end
+}
+
+// LCSubstringLength returns the length, in runes, of the longest common
+// substring of two strings.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//   - int: The length of the longest common substring.
+//
+// Behaviors:
+//   - This takes O(len(a) * len(b)) time but only O(len(b)) memory.
+func LCSubstringLength(a, b string) int {
+	best, _ := lcsubstring([]rune(a), []rune(b))
+
+	return best
+}
+
+// LCSubstring returns the longest common substring of two strings; that
+// is, the longest string that appears contiguously in both strings.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//   - string: The longest common substring.
+//
+// Behaviors:
+//   - If there are several longest common substrings, the one that ends
+//     first in a is returned.
+//   - This takes O(len(a) * len(b)) time but only O(len(b)) memory.
+//
+// Example:
+//
+//	fmt.Println(LCSubstring("xabcdy", "zabcw")) // abc
+func LCSubstring(a, b string) string {
+	ra := []rune(a)
+
+	best, end := lcsubstring(ra, []rune(b))
+
+	return string(ra[end-best : end])
+}
+
+// SimilarityRatio returns how similar two strings are, based on their
+// longest common subsequence.
+//
+// Parameters:
+//   - a: The first string.
+//   - b: The second string.
+//
+// Returns:
+//   - float64: 2 * LCSLength(a, b) / (number of runes of a and b), between
+//     0 (nothing in common) and 1 (equal strings).
+//
+// Behaviors:
+//   - Two empty strings are equal, so their ratio is 1.
+func SimilarityRatio(a, b string) float64 {
+	total := len([]rune(a)) + len([]rune(b))
+	if total == 0 {
+		return 1
+	}
+
+	return 2 * float64(LCSLength(a, b)) / float64(total)
+}
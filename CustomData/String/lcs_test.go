@@ -0,0 +1,61 @@
+package String
+
+import (
+	"testing"
+)
+
+func TestLCS(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		lcs    string
+		substr string
+	}{
+		{"ABCBDAB", "BDCABA", "BCBA", "AB"},
+		{"xabcdy", "zabcw", "abc", "abc"},
+		{"héllo wörld", "hello world", "hllo wrld", "llo w"},
+		{"", "abc", "", ""},
+		{"abc", "def", "", ""},
+	}
+
+	for _, test := range tests {
+		lcs := LCS(test.a, test.b)
+		if len([]rune(lcs)) != LCSLength(test.a, test.b) {
+			t.Errorf("LCSLength(%q, %q) failed: expected %d, got %d", test.a, test.b, len([]rune(lcs)), LCSLength(test.a, test.b))
+		}
+
+		if len([]rune(lcs)) != len([]rune(test.lcs)) {
+			t.Errorf("LCS(%q, %q) failed: expected %q, got %q", test.a, test.b, test.lcs, lcs)
+		}
+
+		substr := LCSubstring(test.a, test.b)
+		if substr != test.substr {
+			t.Errorf("LCSubstring(%q, %q) failed: expected %q, got %q", test.a, test.b, test.substr, substr)
+		}
+
+		if n := LCSubstringLength(test.a, test.b); n != len([]rune(test.substr)) {
+			t.Errorf("LCSubstringLength(%q, %q) failed: expected %d, got %d", test.a, test.b, len([]rune(test.substr)), n)
+		}
+	}
+
+	if lcs := LCS("héllo wörld", "hello world"); lcs != "hllo wrld" {
+		t.Errorf("LCS failed: expected %q, got %q", "hllo wrld", lcs)
+	}
+}
+
+func TestSimilarityRatio(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"", "", 1},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"abcd", "abxy", 0.5},
+	}
+
+	for _, test := range tests {
+		if got := SimilarityRatio(test.a, test.b); got != test.expected {
+			t.Errorf("SimilarityRatio(%q, %q) failed: expected %v, got %v", test.a, test.b, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package String
+
+import (
+	"cmp"
+	"slices"
+	"unicode/utf8"
+
+	uc "github.com/PlayerR9/lib_units/common"
+	lup "github.com/PlayerR9/lib_units/pair"
+)
+
+// WordFrequencies counts the occurrences of each word.
+//
+// Parameters:
+//   - words: The words. (e.g., the result of strings.Fields)
+//
+// Returns:
+//   - map[string]int: The number of occurrences of each word. Never nil.
+//
+// Behaviors:
+//   - Words are compared as is; normalize them beforehand (e.g., with
+//     strings.ToLower) to count them case-insensitively.
+func WordFrequencies(words []string) map[string]int {
+	freqs := make(map[string]int)
+
+	for _, word := range words {
+		freqs[word]++
+	}
+
+	return freqs
+}
+
+// TopK returns the k most frequent words.
+//
+// Parameters:
+//   - freqs: The frequencies. (e.g., the result of WordFrequencies)
+//   - k: The maximum number of words to return.
+//
+// Returns:
+//   - []pair.Pair[string, int]: The words and their frequencies, from the
+//     most to the least frequent. Nil if k is not positive.
+//
+// Behaviors:
+//   - Words with the same frequency are sorted alphabetically, so that the
+//     result does not depend on the iteration order of the map.
+func TopK(freqs map[string]int, k int) []lup.Pair[string, int] {
+	if k <= 0 || len(freqs) == 0 {
+		return nil
+	}
+
+	pairs := make([]lup.Pair[string, int], 0, len(freqs))
+
+	for word, n := range freqs {
+		pairs = append(pairs, lup.NewPair(word, n))
+	}
+
+	slices.SortFunc(pairs, func(a, b lup.Pair[string, int]) int {
+		c := cmp.Compare(b.Second, a.Second)
+		if c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.First, b.First)
+	})
+
+	if k < len(pairs) {
+		pairs = pairs[:k]
+	}
+
+	return pairs
+}
+
+// rune_ngrams is the iterator returned by RuneNGrams.
+type rune_ngrams struct {
+	// str is the string.
+	str string
+
+	// n is the number of runes of each n-gram.
+	n int
+
+	// start is the byte offset of the next n-gram.
+	start int
+
+	// end is the byte offset after the next n-gram. -1 if not computed yet.
+	end int
+
+	// done is true if there are no more n-grams.
+	done bool
+}
+
+// Consume implements the common.Iterater interface.
+func (it *rune_ngrams) Consume() (string, error) {
+	if it.end < 0 && !it.done {
+		if utf8.RuneCountInString(it.str) < it.n {
+			it.done = true
+		} else {
+			it.end = rune_offset(it.str, it.n)
+		}
+	}
+
+	if it.done {
+		return "", uc.NewErrExhaustedIter()
+	}
+
+	gram := it.str[it.start:it.end]
+
+	if it.end == len(it.str) {
+		it.done = true
+
+		return gram, nil
+	}
+
+	_, size := utf8.DecodeRuneInString(it.str[it.start:])
+	it.start += size
+
+	_, size = utf8.DecodeRuneInString(it.str[it.end:])
+	it.end += size
+
+	return gram, nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *rune_ngrams) Restart() {
+	it.start = 0
+	it.end = -1
+	it.done = false
+}
+
+// RuneNGrams returns a lazy iterator over the n-grams of runes of a string;
+// that is, its substrings of n consecutive runes, from left to right.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of runes of each n-gram.
+//
+// Returns:
+//   - common.Iterater[string]: The iterator.
+//
+// Behaviors:
+//   - If n is not positive or the string has fewer than n runes, the
+//     iterator is empty.
+//   - The n-grams are substrings of str, so no string is allocated.
+//
+// Example:
+//
+//	iter := RuneNGrams("héllo", 3)
+//	// "hél", "éll", "llo"
+func RuneNGrams(str string, n int) uc.Iterater[string] {
+	if n <= 0 {
+		return uc.NewSimpleIterator[string](nil)
+	}
+
+	return &rune_ngrams{
+		str: str,
+		n:   n,
+		end: -1,
+	}
+}
+
+// word_ngrams is the iterator returned by WordNGrams.
+type word_ngrams struct {
+	// words are the words.
+	words []string
+
+	// n is the number of words of each n-gram.
+	n int
+
+	// pos is the index of the first word of the next n-gram.
+	pos int
+}
+
+// Consume implements the common.Iterater interface.
+func (it *word_ngrams) Consume() ([]string, error) {
+	if it.pos+it.n > len(it.words) {
+		return nil, uc.NewErrExhaustedIter()
+	}
+
+	gram := it.words[it.pos : it.pos+it.n : it.pos+it.n]
+	it.pos++
+
+	return gram, nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *word_ngrams) Restart() {
+	it.pos = 0
+}
+
+// WordNGrams returns a lazy iterator over the n-grams of a sequence of
+// words; that is, its runs of n consecutive words, from left to right.
+//
+// Parameters:
+//   - words: The words. (e.g., the result of strings.Fields)
+//   - n: The number of words of each n-gram.
+//
+// Returns:
+//   - common.Iterater[[]string]: The iterator.
+//
+// Behaviors:
+//   - If n is not positive or there are fewer than n words, the iterator
+//     is empty.
+//   - The n-grams share the storage of words and must not be modified.
+//
+// Example:
+//
+//	iter := WordNGrams([]string{"a", "b", "c"}, 2)
+//	// ["a" "b"], ["b" "c"]
+func WordNGrams(words []string, n int) uc.Iterater[[]string] {
+	if n <= 0 {
+		return uc.NewSimpleIterator[[]string](nil)
+	}
+
+	return &word_ngrams{
+		words: words,
+		n:     n,
+	}
+}
@@ -0,0 +1,84 @@
+package String
+
+import (
+	"slices"
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+	lup "github.com/PlayerR9/lib_units/pair"
+)
+
+// drain consumes an iterator until it is exhausted.
+func drain[T any](iter uc.Iterater[T]) []T {
+	var values []T
+
+	for {
+		value, err := iter.Consume()
+		if err != nil {
+			return values
+		}
+
+		values = append(values, value)
+	}
+}
+
+func TestWordFrequencies(t *testing.T) {
+	freqs := WordFrequencies([]string{"b", "a", "c", "b", "a", "b", "d"})
+
+	expected := []lup.Pair[string, int]{
+		lup.NewPair("b", 3),
+		lup.NewPair("a", 2),
+		lup.NewPair("c", 1),
+	}
+
+	if got := TopK(freqs, 3); !slices.Equal(got, expected) {
+		t.Errorf("TopK failed: expected %v, got %v", expected, got)
+	}
+
+	if got := TopK(freqs, 10); len(got) != 4 {
+		t.Errorf("TopK failed: expected %d pairs, got %d", 4, len(got))
+	}
+
+	if got := TopK(freqs, 0); got != nil {
+		t.Errorf("TopK failed: expected nil, got %v", got)
+	}
+}
+
+func TestRuneNGrams(t *testing.T) {
+	tests := []struct {
+		str      string
+		n        int
+		expected []string
+	}{
+		{"héllo", 3, []string{"hél", "éll", "llo"}},
+		{"héllo", 5, []string{"héllo"}},
+		{"héllo", 6, nil},
+		{"ab", 1, []string{"a", "b"}},
+		{"", 1, nil},
+		{"ab", 0, nil},
+	}
+
+	for _, test := range tests {
+		iter := RuneNGrams(test.str, test.n)
+
+		for round := 0; round < 2; round++ {
+			if got := drain(iter); !slices.Equal(got, test.expected) {
+				t.Errorf("RuneNGrams(%q, %d) failed: expected %q, got %q", test.str, test.n, test.expected, got)
+			}
+
+			iter.Restart()
+		}
+	}
+}
+
+func TestWordNGrams(t *testing.T) {
+	grams := drain(WordNGrams([]string{"a", "b", "c"}, 2))
+
+	if len(grams) != 2 || !slices.Equal(grams[0], []string{"a", "b"}) || !slices.Equal(grams[1], []string{"b", "c"}) {
+		t.Errorf("WordNGrams failed: expected [[a b] [b c]], got %v", grams)
+	}
+
+	if grams := drain(WordNGrams([]string{"a"}, 2)); grams != nil {
+		t.Errorf("WordNGrams failed: expected nil, got %v", grams)
+	}
+}
@@ -0,0 +1,231 @@
+package String
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Position is a position in a RuneStream.
+type Position struct {
+	// Offset is the number of runes before the position, counting "\r\n"
+	// as one rune.
+	Offset int
+
+	// Line is the line of the position, starting at 1.
+	Line int
+
+	// Column is the column of the position, in runes, starting at 1.
+	Column int
+}
+
+// stream_rune is a rune read from the source of a RuneStream.
+type stream_rune struct {
+	// r is the rune, after normalization.
+	r rune
+
+	// size is the number of bytes read from the source for the rune.
+	size int
+}
+
+// RuneStream reads the runes of a string or of an io.Reader, with
+// lookahead, pushback, and position tracking. It implements io.RuneScanner.
+//
+// Line endings are normalized: "\r\n" and a lone '\r' are both read as a
+// single '\n'.
+type RuneStream struct {
+	// src is the source.
+	src *bufio.Reader
+
+	// ahead are the runes read from the source but not consumed yet.
+	ahead []stream_rune
+
+	// err is the error the source failed with, once reached.
+	err error
+
+	// pos is the position of the next rune.
+	pos Position
+
+	// last is the last consumed rune. Nil if it cannot be unread.
+	last *stream_rune
+
+	// last_pos is the position of the last consumed rune.
+	last_pos Position
+}
+
+// NewRuneStream creates a new RuneStream over a reader.
+//
+// Parameters:
+//   - r: The reader. If nil, the stream is empty.
+//
+// Returns:
+//   - *RuneStream: A pointer to the new RuneStream.
+func NewRuneStream(r io.Reader) *RuneStream {
+	if r == nil {
+		r = strings.NewReader("")
+	}
+
+	rs := &RuneStream{
+		src: bufio.NewReader(r),
+		pos: Position{
+			Offset: 0,
+			Line:   1,
+			Column: 1,
+		},
+	}
+
+	return rs
+}
+
+// NewRuneStreamString creates a new RuneStream over a string.
+//
+// Parameters:
+//   - str: The string.
+//
+// Returns:
+//   - *RuneStream: A pointer to the new RuneStream.
+func NewRuneStreamString(str string) *RuneStream {
+	return NewRuneStream(strings.NewReader(str))
+}
+
+// fill reads runes from the source until n runes are ahead or the source
+// fails.
+//
+// Parameters:
+//   - n: The number of runes needed ahead.
+func (rs *RuneStream) fill(n int) {
+	for len(rs.ahead) < n && rs.err == nil {
+		r, size, err := rs.src.ReadRune()
+		if err != nil {
+			rs.err = err
+			break
+		}
+
+		if r == '\r' {
+			r = '\n'
+
+			next, _, err := rs.src.ReadRune()
+			if err == nil && next == '\n' {
+				size++
+			} else if err == nil {
+				_ = rs.src.UnreadRune()
+			}
+		}
+
+		rs.ahead = append(rs.ahead, stream_rune{r: r, size: size})
+	}
+}
+
+// ReadRune implements the io.RuneReader interface.
+//
+// Returns:
+//   - rune: The next rune. utf8.RuneError for invalid UTF-8.
+//   - int: The number of bytes read from the source for the rune; 2 for a
+//     normalized "\r\n".
+//   - error: io.EOF at the end of the stream, or the error the source
+//     failed with.
+func (rs *RuneStream) ReadRune() (rune, int, error) {
+	rs.fill(1)
+
+	if len(rs.ahead) == 0 {
+		rs.last = nil
+
+		return 0, 0, rs.err
+	}
+
+	sr := rs.ahead[0]
+	rs.ahead = rs.ahead[1:]
+
+	rs.last = &sr
+	rs.last_pos = rs.pos
+
+	rs.pos.Offset++
+
+	if sr.r == '\n' {
+		rs.pos.Line++
+		rs.pos.Column = 1
+	} else {
+		rs.pos.Column++
+	}
+
+	return sr.r, sr.size, nil
+}
+
+// UnreadRune implements the io.RuneScanner interface.
+//
+// Returns:
+//   - error: bufio.ErrInvalidUnreadRune if the last call was not a
+//     successful ReadRune or Next.
+func (rs *RuneStream) UnreadRune() error {
+	if rs.last == nil {
+		return bufio.ErrInvalidUnreadRune
+	}
+
+	rs.ahead = append([]stream_rune{*rs.last}, rs.ahead...)
+	rs.pos = rs.last_pos
+	rs.last = nil
+
+	return nil
+}
+
+// Next consumes the next rune. (see ReadRune)
+//
+// Returns:
+//   - rune: The next rune.
+//   - error: io.EOF at the end of the stream, or the error the source
+//     failed with.
+func (rs *RuneStream) Next() (rune, error) {
+	r, _, err := rs.ReadRune()
+
+	return r, err
+}
+
+// Unread pushes back the last rune consumed. (see UnreadRune)
+//
+// Returns:
+//   - error: bufio.ErrInvalidUnreadRune if there is no rune to push back.
+func (rs *RuneStream) Unread() error {
+	return rs.UnreadRune()
+}
+
+// Peek returns the next n runes without consuming them.
+//
+// Parameters:
+//   - n: The number of runes.
+//
+// Returns:
+//   - []rune: The next runes. Fewer than n if the stream ends before.
+//   - error: io.EOF, or the error the source failed with, if fewer than n
+//     runes are returned.
+//
+// Behaviors:
+//   - If n is not positive, nil is returned.
+//   - Peek does not prevent Unread from pushing back the last consumed
+//     rune.
+func (rs *RuneStream) Peek(n int) ([]rune, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	rs.fill(n)
+
+	runes := make([]rune, 0, min(n, len(rs.ahead)))
+
+	for _, sr := range rs.ahead[:min(n, len(rs.ahead))] {
+		runes = append(runes, sr.r)
+	}
+
+	if len(runes) < n {
+		return runes, rs.err
+	}
+
+	return runes, nil
+}
+
+// Pos returns the position of the next rune.
+//
+// Returns:
+//   - Position: The position.
+func (rs *RuneStream) Pos() Position {
+	return rs.pos
+}
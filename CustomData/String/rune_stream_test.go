@@ -0,0 +1,97 @@
+package String
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRuneStream(t *testing.T) {
+	var rs io.RuneScanner = NewRuneStream(strings.NewReader("hé\r\nx\ry"))
+
+	stream := rs.(*RuneStream)
+
+	peek, err := stream.Peek(3)
+	if err != nil {
+		t.Fatalf("Peek failed: %s", err.Error())
+	}
+
+	if expected := []rune{'h', 'é', '\n'}; !slices.Equal(peek, expected) {
+		t.Errorf("Peek failed: expected %q, got %q", expected, peek)
+	}
+
+	expected := []struct {
+		r    rune
+		size int
+		pos  Position
+	}{
+		{'h', 1, Position{Offset: 1, Line: 1, Column: 2}},
+		{'é', 2, Position{Offset: 2, Line: 1, Column: 3}},
+		{'\n', 2, Position{Offset: 3, Line: 2, Column: 1}},
+		{'x', 1, Position{Offset: 4, Line: 2, Column: 2}},
+		{'\n', 1, Position{Offset: 5, Line: 3, Column: 1}},
+		{'y', 1, Position{Offset: 6, Line: 3, Column: 2}},
+	}
+
+	for _, e := range expected {
+		r, size, err := rs.ReadRune()
+		if err != nil {
+			t.Fatalf("ReadRune failed: %s", err.Error())
+		}
+
+		if r != e.r || size != e.size || stream.Pos() != e.pos {
+			t.Errorf("ReadRune failed: expected %q (%d) at %v, got %q (%d) at %v", e.r, e.size, e.pos, r, size, stream.Pos())
+		}
+	}
+
+	_, err = stream.Next()
+	if err != io.EOF {
+		t.Errorf("Next failed: expected %v, got %v", io.EOF, err)
+	}
+
+	peek, err = stream.Peek(1)
+	if err != io.EOF || len(peek) != 0 {
+		t.Errorf("Peek failed: expected no rune and %v, got %q and %v", io.EOF, peek, err)
+	}
+}
+
+func TestRuneStreamUnread(t *testing.T) {
+	stream := NewRuneStreamString("a\nb")
+
+	err := stream.Unread()
+	if !errors.Is(err, bufio.ErrInvalidUnreadRune) {
+		t.Errorf("Unread failed: expected %v, got %v", bufio.ErrInvalidUnreadRune, err)
+	}
+
+	_, _ = stream.Next()
+	_, _ = stream.Next()
+
+	if expected := (Position{Offset: 2, Line: 2, Column: 1}); stream.Pos() != expected {
+		t.Errorf("Pos failed: expected %v, got %v", expected, stream.Pos())
+	}
+
+	_, _ = stream.Peek(2)
+
+	err = stream.Unread()
+	if err != nil {
+		t.Fatalf("Unread failed: %s", err.Error())
+	}
+
+	if expected := (Position{Offset: 1, Line: 1, Column: 2}); stream.Pos() != expected {
+		t.Errorf("Unread failed: expected %v, got %v", expected, stream.Pos())
+	}
+
+	// Only the last rune can be pushed back.
+	err = stream.Unread()
+	if !errors.Is(err, bufio.ErrInvalidUnreadRune) {
+		t.Errorf("Unread failed: expected %v, got %v", bufio.ErrInvalidUnreadRune, err)
+	}
+
+	r, err := stream.Next()
+	if err != nil || r != '\n' {
+		t.Errorf("Next failed: expected %q, got %q (%v)", '\n', r, err)
+	}
+}
@@ -1,8 +1,11 @@
 package String
 
 import (
+	"fmt"
 	"strings"
 	"unicode/utf8"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 )
 
 // String represents a unit of data in a draw table with a specific style.
@@ -167,23 +170,33 @@ func (s *String) PrependString(otherS string) {
 // Returns:
 //   - bool: True if the suffix was replaced, and false otherwise.
 func (s *String) ReplaceSuffix(suffix string) bool {
+	err := s.ReplaceSuffixErr(suffix)
+	return err == nil
+}
+
+// ReplaceSuffixErr is like ReplaceSuffix but reports why the suffix could
+// not be replaced.
+//
+// Parameters:
+//   - suffix: The suffix to replace the end of the string.
+//
+// Returns:
+//   - error: An error that wraps errors.ErrTooLong if the suffix is longer
+//     than the string.
+func (s *String) ReplaceSuffixErr(suffix string) error {
 	if suffix == "" {
-		return true
+		return nil
 	}
 
 	countSuffix := utf8.RuneCountInString(suffix)
 
 	if s.length < countSuffix {
-		return false
+		return fmt.Errorf("suffix %q: %w", suffix, ers.ErrTooLong)
 	}
 
-	if s.length == countSuffix {
-		s.content = suffix
-	} else {
-		s.content = s.content[:s.length-countSuffix] + suffix
-	}
+	s.content = TrimSuffixRunes(s.content, countSuffix) + suffix
 
-	return true
+	return nil
 }
 
 // TrimEnd trims the end of the string to the given limit.
@@ -210,7 +223,7 @@ func (s *String) TrimEnd(limit int) *String {
 	}
 
 	return &String{
-		content: s.content[:limit],
+		content: s.content[:rune_offset(s.content, limit)],
 		length:  limit,
 	}
 }
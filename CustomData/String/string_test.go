@@ -0,0 +1,47 @@
+package String
+
+import (
+	"errors"
+	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+func TestMultiByteSuffix(t *testing.T) {
+	s := NewString("héllo")
+
+	ok := s.ReplaceSuffix("!!")
+	if !ok {
+		t.Fatalf("ReplaceSuffix failed: expected true, got false")
+	}
+
+	if got := s.GetContent(); got != "hél!!" {
+		t.Errorf("ReplaceSuffix failed: expected %q, got %q", "hél!!", got)
+	}
+
+	trimmed := NewString("héllo").TrimEnd(2)
+	if got := trimmed.GetContent(); got != "hé" {
+		t.Errorf("TrimEnd failed: expected %q, got %q", "hé", got)
+	}
+
+	if got := TrimSuffixRunes("héllo", 3); got != "hé" {
+		t.Errorf("TrimSuffixRunes failed: expected %q, got %q", "hé", got)
+	}
+
+	if got := TrimPrefixRunes("héllo", 2); got != "llo" {
+		t.Errorf("TrimPrefixRunes failed: expected %q, got %q", "llo", got)
+	}
+}
+
+func TestReplaceSuffixErr(t *testing.T) {
+	s := NewString("ab")
+
+	err := s.ReplaceSuffixErr("xyz")
+	if !errors.Is(err, ers.ErrTooLong) {
+		t.Errorf("ReplaceSuffixErr failed: expected %v, got %v", ers.ErrTooLong, err)
+	}
+
+	if got := s.GetContent(); got != "ab" {
+		t.Errorf("ReplaceSuffixErr failed: expected %q, got %q", "ab", got)
+	}
+}
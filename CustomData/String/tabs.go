@@ -0,0 +1,131 @@
+package String
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// ExpandTabs replaces the tabs of a string with the spaces needed to reach
+// the next tab stop.
+//
+// Parameters:
+//   - s: The string.
+//   - tabstop: The number of columns between two tab stops.
+//
+// Returns:
+//   - string: The string without tabs.
+//
+// Behaviors:
+//   - Columns are counted in display width, so wide runes (e.g., CJK) count
+//     as two columns and combining marks as none.
+//   - Each line ('\n' or '\r') starts at column 0.
+//   - If tabstop is not positive, tabs are removed.
+//
+// Example:
+//
+//	fmt.Printf("%q\n", ExpandTabs("ab\tc\td", 4)) // "ab  c   d"
+func ExpandTabs(s string, tabstop int) string {
+	if !strings.ContainsRune(s, '\t') {
+		return s
+	}
+
+	var builder strings.Builder
+
+	var col int
+
+	for _, r := range s {
+		switch r {
+		case '\t':
+			if tabstop <= 0 {
+				continue
+			}
+
+			n := tabstop - col%tabstop
+
+			builder.WriteString(strings.Repeat(" ", n))
+			col += n
+		case '\n', '\r':
+			builder.WriteRune(r)
+			col = 0
+		default:
+			builder.WriteRune(r)
+			col += runewidth.RuneWidth(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// Entab replaces the runs of spaces of a string that end on a tab stop with
+// tabs. It is the inverse of ExpandTabs.
+//
+// Parameters:
+//   - s: The string.
+//   - tabstop: The number of columns between two tab stops.
+//
+// Returns:
+//   - string: The string with tabs.
+//
+// Behaviors:
+//   - Columns are counted in display width, as in ExpandTabs; so that
+//     ExpandTabs(Entab(s, n), n) == s for any string s without tabs.
+//   - A single space is never replaced, even if it ends on a tab stop.
+//   - Spaces right before a tab are absorbed by the tab.
+//   - If tabstop is not positive, the string is returned as is.
+//
+// Example:
+//
+//	fmt.Printf("%q\n", Entab("ab  c   d", 4)) // "ab\tc\td"
+func Entab(s string, tabstop int) string {
+	if tabstop <= 0 || !strings.ContainsRune(s, ' ') {
+		return s
+	}
+
+	var builder strings.Builder
+
+	var col, spaces int
+
+	flush := func() {
+		builder.WriteString(strings.Repeat(" ", spaces))
+		spaces = 0
+	}
+
+	for _, r := range s {
+		switch r {
+		case ' ':
+			spaces++
+			col++
+
+			if col%tabstop != 0 {
+				continue
+			}
+
+			if spaces > 1 {
+				builder.WriteRune('\t')
+				spaces = 0
+			} else {
+				flush()
+			}
+		case '\t':
+			spaces = 0
+
+			builder.WriteRune('\t')
+			col += tabstop - col%tabstop
+		case '\n', '\r':
+			flush()
+
+			builder.WriteRune(r)
+			col = 0
+		default:
+			flush()
+
+			builder.WriteRune(r)
+			col += runewidth.RuneWidth(r)
+		}
+	}
+
+	flush()
+
+	return builder.String()
+}
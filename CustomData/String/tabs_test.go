@@ -0,0 +1,50 @@
+package String
+
+import (
+	"testing"
+)
+
+func TestExpandTabs(t *testing.T) {
+	tests := []struct {
+		s        string
+		tabstop  int
+		expected string
+	}{
+		{"ab\tc\td", 4, "ab  c   d"},
+		{"\tx\n\ty", 2, "  x\n  y"},
+		{"日本\tx", 4, "日本    x"},
+		{"é\tx", 4, "é   x"},
+		{"a\tb", 0, "ab"},
+	}
+
+	for _, test := range tests {
+		if got := ExpandTabs(test.s, test.tabstop); got != test.expected {
+			t.Errorf("ExpandTabs(%q, %d) failed: expected %q, got %q", test.s, test.tabstop, test.expected, got)
+		}
+	}
+}
+
+func TestEntab(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"ab  c   d", "ab\tc\td"},
+		{"abc d", "abc d"},
+		{"        x", "\t\tx"},
+		{"日本    x", "日本\tx"},
+		{"a  \tb", "a\tb"},
+		{"x  ", "x  "},
+	}
+
+	for _, test := range tests {
+		got := Entab(test.s, 4)
+		if got != test.expected {
+			t.Errorf("Entab(%q, 4) failed: expected %q, got %q", test.s, test.expected, got)
+		}
+
+		if expanded := ExpandTabs(test.s, 4); ExpandTabs(got, 4) != expanded {
+			t.Errorf("Entab(%q, 4) failed: expected %q once expanded, got %q", test.s, expanded, ExpandTabs(got, 4))
+		}
+	}
+}
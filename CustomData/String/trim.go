@@ -0,0 +1,125 @@
+package String
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// rune_offset returns the byte offset of the n-th rune of a string.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of runes to skip.
+//
+// Returns:
+//   - int: The byte offset. 0 if n is not positive, and len(str) if the
+//     string has fewer than n runes.
+func rune_offset(str string, n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	for i := range str {
+		if n == 0 {
+			return i
+		}
+
+		n--
+	}
+
+	return len(str)
+}
+
+// TrimPrefixRunes removes the first n runes of a string.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of runes to remove.
+//
+// Returns:
+//   - string: The trimmed string.
+//
+// Behaviors:
+//   - If n is not positive, the string is returned as is.
+//   - If the string has at most n runes, an empty string is returned.
+//   - Multi-byte runes are never split.
+func TrimPrefixRunes(str string, n int) string {
+	return str[rune_offset(str, n):]
+}
+
+// TrimSuffixRunes removes the last n runes of a string.
+//
+// Parameters:
+//   - str: The string.
+//   - n: The number of runes to remove.
+//
+// Returns:
+//   - string: The trimmed string.
+//
+// Behaviors:
+//   - If n is not positive, the string is returned as is.
+//   - If the string has at most n runes, an empty string is returned.
+//   - Multi-byte runes are never split.
+func TrimSuffixRunes(str string, n int) string {
+	end := len(str)
+
+	for ; n > 0 && end > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(str[:end])
+		end -= size
+	}
+
+	return str[:end]
+}
+
+// EnsurePrefix adds a prefix to a string unless it already starts with it.
+//
+// Parameters:
+//   - str: The string.
+//   - prefix: The prefix.
+//
+// Returns:
+//   - string: The string, starting with the prefix.
+func EnsurePrefix(str, prefix string) string {
+	if strings.HasPrefix(str, prefix) {
+		return str
+	}
+
+	return prefix + str
+}
+
+// EnsureSuffix adds a suffix to a string unless it already ends with it.
+//
+// Parameters:
+//   - str: The string.
+//   - suffix: The suffix.
+//
+// Returns:
+//   - string: The string, ending with the suffix.
+func EnsureSuffix(str, suffix string) string {
+	if strings.HasSuffix(str, suffix) {
+		return str
+	}
+
+	return str + suffix
+}
+
+// ChompNewline removes a single trailing line ending from a string.
+//
+// Parameters:
+//   - str: The string.
+//
+// Returns:
+//   - string: The string without its trailing "\n" or "\r\n".
+//
+// Behaviors:
+//   - Only one line ending is removed; "a\n\n" becomes "a\n".
+//   - A lone trailing "\r" is kept.
+func ChompNewline(str string) string {
+	if !strings.HasSuffix(str, "\n") {
+		return str
+	}
+
+	str = str[:len(str)-1]
+
+	return strings.TrimSuffix(str, "\r")
+}
@@ -0,0 +1,149 @@
+package String
+
+import (
+	"strings"
+)
+
+// space_runs splits a line into runs of spaces and runs of other characters,
+// in order.
+//
+// Parameters:
+//   - line: The line.
+//
+// Returns:
+//   - []string: The runs. Nil if the line is empty.
+func space_runs(line string) []string {
+	var runs []string
+
+	start := 0
+
+	for i := 1; i <= len(line); i++ {
+		if i == len(line) || (line[i] == ' ') != (line[start] == ' ') {
+			runs = append(runs, line[start:i])
+			start = i
+		}
+	}
+
+	return runs
+}
+
+// WrapPreserving wraps a text so that each line takes at most width columns
+// once printed. Unlike splitting the text into words (e.g., with
+// strings.Fields), the spaces between words are kept as they are; so code
+// snippets and preformatted text are not mangled.
+//
+// Parameters:
+//   - s: The text.
+//   - width: The maximum number of columns of a line.
+//   - tabstop: The number of columns between two tab stops.
+//
+// Returns:
+//   - []string: The lines. Nil if the text is empty.
+//
+// Behaviors:
+//   - Tabs are expanded first, as in ExpandTabs.
+//   - The text is first split on '\n'; each of its lines is wrapped on its
+//     own, and empty lines are kept.
+//   - The spaces where a line is broken stay at the end of that line and do
+//     not count towards the width. Thus, the wrapping can be undone:
+//     concatenating the lines wrapped from a line of the text gives it back,
+//     tabs expanded. Use strings.TrimRight to drop them before printing.
+//   - A word wider than width is broken between grapheme clusters; a single
+//     cluster wider than width takes a line of its own.
+//   - If width is not positive, the lines are not wrapped.
+//
+// Example:
+//
+//	lines := WrapPreserving("x  := 1\t// one", 10, 4)
+//	// lines = []string{"x  := 1 // ", "one"}
+func WrapPreserving(s string, width, tabstop int) []string {
+	if s == "" {
+		return nil
+	}
+
+	var lines []string
+
+	for _, line := range strings.Split(ExpandTabs(s, tabstop), "\n") {
+		if width <= 0 {
+			lines = append(lines, line)
+		} else {
+			lines = append(lines, wrap_line(line, width)...)
+		}
+	}
+
+	return lines
+}
+
+// wrap_line wraps a line without tabs nor newlines.
+//
+// Parameters:
+//   - line: The line.
+//   - width: The maximum number of columns. Assumed to be positive.
+//
+// Returns:
+//   - []string: The wrapped lines. Never empty.
+func wrap_line(line string, width int) []string {
+	var lines []string
+
+	var builder strings.Builder
+
+	var used int
+
+	flush := func() {
+		lines = append(lines, builder.String())
+		builder.Reset()
+		used = 0
+	}
+
+	for _, run := range space_runs(line) {
+		w := VisibleWidth(run)
+
+		if used+w <= width {
+			builder.WriteString(run)
+			used += w
+
+			continue
+		}
+
+		if run[0] == ' ' {
+			// The spaces hang at the end of the line.
+			builder.WriteString(run)
+			flush()
+
+			continue
+		}
+
+		if used > 0 {
+			flush()
+		}
+
+		if w <= width {
+			builder.WriteString(run)
+			used = w
+
+			continue
+		}
+
+		clusters := graphemes(run)
+
+		for i, cluster := range clusters {
+			end := len(run)
+			if i+1 < len(clusters) {
+				end = clusters[i+1].start
+			}
+
+			if used > 0 && used+cluster.width > width {
+				flush()
+			}
+
+			builder.WriteString(run[cluster.start:end])
+			used += cluster.width
+		}
+	}
+
+	if builder.Len() > 0 || len(lines) == 0 {
+		lines = append(lines, builder.String())
+	}
+
+	return lines
+}
@@ -0,0 +1,54 @@
+package String
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWrapPreserving(t *testing.T) {
+	tests := []struct {
+		s        string
+		width    int
+		expected []string
+	}{
+		{"x  := 1\t// one", 10, []string{"x  := 1 // ", "one"}},
+		{"a  b   c", 4, []string{"a  b   ", "c"}},
+		{"    indented  text", 12, []string{"    indented  ", "text"}},
+		{"abcdefgh ij", 3, []string{"abc", "def", "gh ", "ij"}},
+		{"日本語", 3, []string{"日", "本", "語"}},
+		{"one\n\ntwo  three", 5, []string{"one", "", "two  ", "three"}},
+		{"abc   ", 4, []string{"abc   "}},
+		{"a\tb", 0, []string{"a   b"}},
+		{"", 4, nil},
+	}
+
+	for _, test := range tests {
+		got := WrapPreserving(test.s, test.width, 4)
+		if !slices.Equal(got, test.expected) {
+			t.Errorf("WrapPreserving(%q, %d) failed: expected %q, got %q", test.s, test.width, test.expected, got)
+		}
+	}
+}
+
+func TestWrapPreservingUndo(t *testing.T) {
+	text := []string{"func f() {", "\treturn  a +\tb // sum", "}"}
+
+	for width := 1; width < 20; width++ {
+		for _, line := range text {
+			wrapped := WrapPreserving(line, width, 8)
+
+			for _, w := range wrapped {
+				if got := VisibleWidth(strings.TrimRight(w, " ")); got > width {
+					t.Errorf("WrapPreserving failed: expected at most %d columns, got %d in %q", width, got, w)
+				}
+			}
+
+			expected := ExpandTabs(line, 8)
+
+			if got := strings.Join(wrapped, ""); got != expected {
+				t.Errorf("WrapPreserving failed: expected %q once joined, got %q", expected, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package Tray
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"slices"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// slot is an element of the window of a ReaderTray.
+type slot[T any] struct {
+	// elem is the element.
+	elem T
+
+	// inserted is true if the element was added with ExtendTapeOnLeft or
+	// ExtendTapeOnRight rather than read from the input.
+	inserted bool
+}
+
+// ReaderTray is a tray whose tape is read lazily from an io.Reader.
+//
+// Only a window of the input is kept in memory: elements are read when
+// the arrow moves past the loaded part of the tape, and elements that are
+// more than the backup size to the left of the arrow are discarded when
+// the arrow moves. Thus, inputs larger than memory can be processed as long
+// as the arrow never needs to go back further than the backup size.
+type ReaderTray[T any] struct {
+	// window is the loaded part of the tape.
+	window *SimpleTray[slot[T]]
+
+	// reader is the reader the tape is read from.
+	reader *bufio.Reader
+
+	// read reads one element from the reader.
+	read func(*bufio.Reader) (T, error)
+
+	// backup is the maximum number of elements kept on the left of the arrow.
+	backup int
+
+	// offset is the number of input elements discarded so far.
+	offset int
+
+	// isDone is true if the reader has no more elements.
+	isDone bool
+
+	// err is the first non-EOF error returned by the reader.
+	err error
+}
+
+// load reads up to n more elements from the reader into the window.
+//
+// Parameters:
+//   - n: The number of elements to read.
+//
+// Returns:
+//   - int: The number of elements that could not be read.
+func (rt *ReaderTray[T]) load(n int) int {
+	for ; n > 0 && !rt.isDone; n-- {
+		elem, err := rt.read(rt.reader)
+		if err != nil {
+			rt.isDone = true
+
+			if !errors.Is(err, io.EOF) {
+				rt.err = err
+			}
+
+			break
+		}
+
+		rt.window.tape = append(rt.window.tape, slot[T]{elem: elem})
+		rt.window.size++
+	}
+
+	return n
+}
+
+// ensure_right makes sure that n elements on the right of the arrow are
+// loaded, if the input has them.
+//
+// Parameters:
+//   - n: The number of elements that must be loaded on the right of the arrow.
+func (rt *ReaderTray[T]) ensure_right(n int) {
+	if rt.window.size == 0 {
+		rt.load(n + 1)
+		return
+	}
+
+	missing := n - rt.window.GetRightDistance()
+	if missing > 0 {
+		rt.load(missing)
+	}
+}
+
+// discard drops the elements that are too far on the left of the arrow.
+//
+// Inserted elements count towards the backup size but not towards the
+// offset, since they are not part of the input.
+func (rt *ReaderTray[T]) discard() {
+	excess := rt.window.arrow - rt.backup
+	if excess <= 0 {
+		return
+	}
+
+	for _, s := range rt.window.tape[:excess] {
+		if !s.inserted {
+			rt.offset++
+		}
+	}
+
+	rt.window.tape = slices.Delete(rt.window.tape, 0, excess)
+	rt.window.size = len(rt.window.tape)
+	rt.window.arrow -= excess
+}
+
+// wrap turns inserted elements into slots.
+//
+// Parameters:
+//   - elems: The elements.
+//
+// Returns:
+//   - []slot[T]: The slots.
+func wrap[T any](elems []T) []slot[T] {
+	slots := make([]slot[T], 0, len(elems))
+
+	for _, elem := range elems {
+		slots = append(slots, slot[T]{elem: elem, inserted: true})
+	}
+
+	return slots
+}
+
+// GetLeftDistance implements the Trayer interface.
+//
+// Only the elements still kept in memory are counted.
+func (rt *ReaderTray[T]) GetLeftDistance() int {
+	return rt.window.GetLeftDistance()
+}
+
+// GetRightDistance implements the Trayer interface.
+//
+// Only the elements already loaded are counted. However, at least one
+// element on the right of the arrow is loaded, if any, so that 0 always
+// means that the arrow is at the end of the input.
+func (rt *ReaderTray[T]) GetRightDistance() int {
+	rt.ensure_right(1)
+
+	return rt.window.GetRightDistance()
+}
+
+// Move implements the Trayer interface.
+//
+// The arrow cannot move further left than the elements kept in memory.
+func (rt *ReaderTray[T]) Move(n int) int {
+	if n > 0 {
+		rt.ensure_right(n)
+	}
+
+	excess := rt.window.Move(n)
+
+	rt.discard()
+
+	return excess
+}
+
+// Write implements the Trayer interface.
+//
+// The element is only written in memory; the underlying reader is never
+// modified.
+func (rt *ReaderTray[T]) Write(elem T) error {
+	rt.ensure_right(0)
+
+	s, err := rt.window.Read()
+	if err != nil {
+		return err
+	}
+
+	s.elem = elem
+
+	return rt.window.Write(s)
+}
+
+// Read implements the Trayer interface.
+func (rt *ReaderTray[T]) Read() (T, error) {
+	rt.ensure_right(0)
+
+	s, err := rt.window.Read()
+	if err != nil {
+		return *new(T), err
+	}
+
+	return s.elem, nil
+}
+
+// Delete implements the Trayer interface.
+func (rt *ReaderTray[T]) Delete(n int) int {
+	if n > 0 {
+		rt.ensure_right(n)
+	}
+
+	return rt.window.Delete(n)
+}
+
+// ExtendTapeOnLeft implements the Trayer interface.
+//
+// The elements are kept until the arrow moves; they are not part of the
+// input, so they are never counted by Position.
+func (rt *ReaderTray[T]) ExtendTapeOnLeft(elems ...T) {
+	if len(elems) == 0 {
+		return
+	}
+
+	rt.ensure_right(0)
+
+	rt.window.ExtendTapeOnLeft(wrap(elems)...)
+}
+
+// ExtendTapeOnRight implements the Trayer interface.
+//
+// The elements are not part of the input, so they are never counted by
+// Position.
+func (rt *ReaderTray[T]) ExtendTapeOnRight(elems ...T) {
+	if len(elems) == 0 {
+		return
+	}
+
+	rt.ensure_right(0)
+
+	rt.window.ExtendTapeOnRight(wrap(elems)...)
+}
+
+// ArrowStart implements the Trayer interface.
+//
+// The arrow is moved to the first element kept in memory.
+func (rt *ReaderTray[T]) ArrowStart() {
+	rt.window.ArrowStart()
+}
+
+// ArrowEnd implements the Trayer interface.
+//
+// WARNING: This reads the whole remaining input; although elements
+// are discarded as they are read, it may take a long time.
+func (rt *ReaderTray[T]) ArrowEnd() {
+	for !rt.isDone {
+		rt.load(rt.backup + 1)
+		rt.window.ArrowEnd()
+		rt.discard()
+	}
+
+	rt.window.ArrowEnd()
+}
+
+// Position returns the position of the arrow in the input; that is, the
+// number of input elements on the left of the arrow, including the ones
+// that were already discarded.
+//
+// Returns:
+//   - int: The position of the arrow.
+//
+// Behaviors:
+//   - Elements added with ExtendTapeOnLeft or ExtendTapeOnRight are not
+//     counted, as they are not part of the input.
+func (rt *ReaderTray[T]) Position() int {
+	pos := rt.offset
+
+	for _, s := range rt.window.tape[:rt.window.arrow] {
+		if !s.inserted {
+			pos++
+		}
+	}
+
+	return pos
+}
+
+// Err returns the first error, other than io.EOF, returned by the reader.
+//
+// Returns:
+//   - error: The error. Nil if the reader never failed.
+//
+// Behaviors:
+//   - Once the reader fails, the tray behaves as if the input ended there.
+func (rt *ReaderTray[T]) Err() error {
+	return rt.err
+}
+
+// NewReaderTray creates a new ReaderTray.
+//
+// Parameters:
+//   - r: The reader to read the tape from.
+//   - read: The function that reads one element from the reader. It must
+//     return io.EOF when there are no more elements.
+//   - backup: The maximum number of elements kept on the left of the arrow.
+//
+// Returns:
+//   - *ReaderTray: A pointer to the new ReaderTray.
+//   - error: An error of type *common.ErrInvalidParameter if r or read is
+//     nil, or if backup is negative.
+func NewReaderTray[T any](r io.Reader, read func(*bufio.Reader) (T, error), backup int) (*ReaderTray[T], error) {
+	if r == nil {
+		return nil, uc.NewErrNilParameter("r")
+	} else if read == nil {
+		return nil, uc.NewErrNilParameter("read")
+	} else if backup < 0 {
+		return nil, uc.NewErrInvalidParameter("backup", ers.NewErrOutOfBound(backup, 0, math.MaxInt))
+	}
+
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+
+	rt := &ReaderTray[T]{
+		window: NewSimpleTray[slot[T]](nil),
+		reader: br,
+		read:   read,
+		backup: backup,
+	}
+
+	return rt, nil
+}
+
+// NewRuneTray creates a new ReaderTray that reads the input rune by rune.
+//
+// Parameters:
+//   - r: The reader to read the tape from.
+//   - backup: The maximum number of runes kept on the left of the arrow.
+//
+// Returns:
+//   - *ReaderTray: A pointer to the new ReaderTray.
+//   - error: An error of type *common.ErrInvalidParameter if r is nil or
+//     backup is negative.
+func NewRuneTray(r io.Reader, backup int) (*ReaderTray[rune], error) {
+	f := func(br *bufio.Reader) (rune, error) {
+		c, _, err := br.ReadRune()
+		return c, err
+	}
+
+	return NewReaderTray(r, f, backup)
+}
+
+// NewByteTray creates a new ReaderTray that reads the input byte by byte.
+//
+// Parameters:
+//   - r: The reader to read the tape from.
+//   - backup: The maximum number of bytes kept on the left of the arrow.
+//
+// Returns:
+//   - *ReaderTray: A pointer to the new ReaderTray.
+//   - error: An error of type *common.ErrInvalidParameter if r is nil or
+//     backup is negative.
+func NewByteTray(r io.Reader, backup int) (*ReaderTray[byte], error) {
+	f := func(br *bufio.Reader) (byte, error) {
+		return br.ReadByte()
+	}
+
+	return NewReaderTray(r, f, backup)
+}
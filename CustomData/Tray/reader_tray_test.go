@@ -0,0 +1,77 @@
+package Tray
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReaderTrayWindow(t *testing.T) {
+	rt, err := NewRuneTray(strings.NewReader("abcdef"), 2)
+	if err != nil {
+		t.Fatalf("NewRuneTray failed: %s", err.Error())
+	}
+
+	if excess := rt.Move(4); excess != 0 {
+		t.Fatalf("Move failed: expected no excess, got %d", excess)
+	}
+
+	r, _ := rt.Read()
+	if r != 'e' || rt.Position() != 4 {
+		t.Errorf("Move failed: expected 'e' at 4, got %q at %d", r, rt.Position())
+	}
+
+	if dist := rt.GetLeftDistance(); dist != 2 {
+		t.Errorf("GetLeftDistance failed: expected %d, got %d", 2, dist)
+	}
+
+	// Only the backup is kept, so the arrow stops at 'c'.
+	if excess := rt.Move(-5); excess != -3 {
+		t.Errorf("Move failed: expected an excess of %d, got %d", -3, excess)
+	}
+
+	r, _ = rt.Read()
+	if r != 'c' || rt.Position() != 2 {
+		t.Errorf("Move failed: expected 'c' at 2, got %q at %d", r, rt.Position())
+	}
+
+	rt.ArrowEnd()
+
+	r, _ = rt.Read()
+	if r != 'f' || rt.Position() != 5 || rt.GetRightDistance() != 0 {
+		t.Errorf("ArrowEnd failed: expected 'f' at 5, got %q at %d", r, rt.Position())
+	}
+}
+
+func TestReaderTrayExtendLeft(t *testing.T) {
+	rt, err := NewRuneTray(strings.NewReader("abc"), 0)
+	if err != nil {
+		t.Fatalf("NewRuneTray failed: %s", err.Error())
+	}
+
+	rt.ExtendTapeOnLeft('x', 'y')
+
+	r, _ := rt.Read()
+	if r != 'a' || rt.Position() != 0 {
+		t.Errorf("ExtendTapeOnLeft failed: expected 'a' at 0, got %q at %d", r, rt.Position())
+	}
+
+	if dist := rt.GetLeftDistance(); dist != 2 {
+		t.Errorf("ExtendTapeOnLeft failed: expected the extension to be kept, got a left distance of %d", dist)
+	}
+
+	rt.Move(-1)
+
+	r, _ = rt.Read()
+	if r != 'y' || rt.Position() != 0 {
+		t.Errorf("Move failed: expected 'y' at 0, got %q at %d", r, rt.Position())
+	}
+
+	// Inserted elements are discarded like the others, but do not count as
+	// input.
+	rt.Move(2)
+
+	r, _ = rt.Read()
+	if r != 'b' || rt.Position() != 1 {
+		t.Errorf("Move failed: expected 'b' at 1, got %q at %d", r, rt.Position())
+	}
+}
@@ -0,0 +1,410 @@
+package Tray
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// OpKind is the kind of an operation performed on a tray.
+type OpKind int8
+
+const (
+	// OpMove is the kind of Move.
+	OpMove OpKind = iota
+
+	// OpWrite is the kind of Write.
+	OpWrite
+
+	// OpRead is the kind of Read.
+	OpRead
+
+	// OpDelete is the kind of Delete.
+	OpDelete
+
+	// OpExtendLeft is the kind of ExtendTapeOnLeft.
+	OpExtendLeft
+
+	// OpExtendRight is the kind of ExtendTapeOnRight.
+	OpExtendRight
+
+	// OpArrowStart is the kind of ArrowStart.
+	OpArrowStart
+
+	// OpArrowEnd is the kind of ArrowEnd.
+	OpArrowEnd
+)
+
+// String implements the fmt.Stringer interface.
+func (k OpKind) String() string {
+	switch k {
+	case OpMove:
+		return "move"
+	case OpWrite:
+		return "write"
+	case OpRead:
+		return "read"
+	case OpDelete:
+		return "delete"
+	case OpExtendLeft:
+		return "extend left"
+	case OpExtendRight:
+		return "extend right"
+	case OpArrowStart:
+		return "arrow start"
+	case OpArrowEnd:
+		return "arrow end"
+	default:
+		return "OpKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Op is an operation performed on a tray, as recorded by a RecordingTray.
+type Op[T any] struct {
+	// Kind is the kind of the operation.
+	Kind OpKind
+
+	// N is the argument of Move and Delete.
+	N int
+
+	// Elems are the elements written, read, or used to extend the tape.
+	Elems []T
+
+	// Pos is the distance from the arrow to the left end of the tape before
+	// the operation.
+	Pos int
+
+	// Result is the value returned by Move and Delete.
+	Result int
+
+	// Failed is true if Write or Read returned an error.
+	Failed bool
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format: "<kind> [<n>] [<elems>] @<pos> [-> <result>] [failed]"
+func (op Op[T]) String() string {
+	values := []string{op.Kind.String()}
+
+	switch op.Kind {
+	case OpMove, OpDelete:
+		values = append(values, strconv.Itoa(op.N))
+	}
+
+	if len(op.Elems) > 0 {
+		values = append(values, fmt.Sprintf("%v", op.Elems))
+	}
+
+	values = append(values, "@"+strconv.Itoa(op.Pos))
+
+	switch op.Kind {
+	case OpMove, OpDelete:
+		values = append(values, "->", strconv.Itoa(op.Result))
+	}
+
+	if op.Failed {
+		values = append(values, "failed")
+	}
+
+	str := strings.Join(values, " ")
+
+	return str
+}
+
+// RecordingTray is a tray decorator that records every operation that
+// changes or reads the tray, so that it can be inspected or replayed
+// later with a ReplayTray.
+//
+// Queries (GetLeftDistance, GetRightDistance) are not recorded.
+type RecordingTray[T any] struct {
+	// tray is the decorated tray.
+	tray Trayer[T]
+
+	// ops are the recorded operations.
+	ops []Op[T]
+}
+
+// record appends an operation to the script.
+//
+// Parameters:
+//   - op: The operation to record.
+func (rt *RecordingTray[T]) record(op Op[T]) {
+	rt.ops = append(rt.ops, op)
+}
+
+// GetLeftDistance implements the Trayer interface.
+func (rt *RecordingTray[T]) GetLeftDistance() int {
+	return rt.tray.GetLeftDistance()
+}
+
+// GetRightDistance implements the Trayer interface.
+func (rt *RecordingTray[T]) GetRightDistance() int {
+	return rt.tray.GetRightDistance()
+}
+
+// Move implements the Trayer interface.
+func (rt *RecordingTray[T]) Move(n int) int {
+	pos := rt.tray.GetLeftDistance()
+
+	excess := rt.tray.Move(n)
+
+	rt.record(Op[T]{Kind: OpMove, N: n, Pos: pos, Result: excess})
+
+	return excess
+}
+
+// Write implements the Trayer interface.
+func (rt *RecordingTray[T]) Write(elem T) error {
+	pos := rt.tray.GetLeftDistance()
+
+	err := rt.tray.Write(elem)
+
+	rt.record(Op[T]{Kind: OpWrite, Elems: []T{elem}, Pos: pos, Failed: err != nil})
+
+	return err
+}
+
+// Read implements the Trayer interface.
+func (rt *RecordingTray[T]) Read() (T, error) {
+	pos := rt.tray.GetLeftDistance()
+
+	elem, err := rt.tray.Read()
+
+	op := Op[T]{Kind: OpRead, Pos: pos, Failed: err != nil}
+	if err == nil {
+		op.Elems = []T{elem}
+	}
+
+	rt.record(op)
+
+	return elem, err
+}
+
+// Delete implements the Trayer interface.
+func (rt *RecordingTray[T]) Delete(n int) int {
+	pos := rt.tray.GetLeftDistance()
+
+	excess := rt.tray.Delete(n)
+
+	rt.record(Op[T]{Kind: OpDelete, N: n, Pos: pos, Result: excess})
+
+	return excess
+}
+
+// ExtendTapeOnLeft implements the Trayer interface.
+func (rt *RecordingTray[T]) ExtendTapeOnLeft(elems ...T) {
+	pos := rt.tray.GetLeftDistance()
+
+	// The tray may adopt elems as its tape, so later writes must not
+	// rewrite the script.
+	recorded := slices.Clone(elems)
+
+	rt.tray.ExtendTapeOnLeft(elems...)
+
+	rt.record(Op[T]{Kind: OpExtendLeft, Elems: recorded, Pos: pos})
+}
+
+// ExtendTapeOnRight implements the Trayer interface.
+func (rt *RecordingTray[T]) ExtendTapeOnRight(elems ...T) {
+	pos := rt.tray.GetLeftDistance()
+
+	recorded := slices.Clone(elems)
+
+	rt.tray.ExtendTapeOnRight(elems...)
+
+	rt.record(Op[T]{Kind: OpExtendRight, Elems: recorded, Pos: pos})
+}
+
+// ArrowStart implements the Trayer interface.
+func (rt *RecordingTray[T]) ArrowStart() {
+	pos := rt.tray.GetLeftDistance()
+
+	rt.tray.ArrowStart()
+
+	rt.record(Op[T]{Kind: OpArrowStart, Pos: pos})
+}
+
+// ArrowEnd implements the Trayer interface.
+func (rt *RecordingTray[T]) ArrowEnd() {
+	pos := rt.tray.GetLeftDistance()
+
+	rt.tray.ArrowEnd()
+
+	rt.record(Op[T]{Kind: OpArrowEnd, Pos: pos})
+}
+
+// clone_script returns a deep copy of a script.
+//
+// Parameters:
+//   - script: The script to copy.
+//
+// Returns:
+//   - []Op[T]: The copy; the elements of each operation are copied too.
+func clone_script[T any](script []Op[T]) []Op[T] {
+	ops := make([]Op[T], len(script))
+
+	for i, op := range script {
+		op.Elems = slices.Clone(op.Elems)
+		ops[i] = op
+	}
+
+	return ops
+}
+
+// Script returns the operations recorded so far.
+//
+// Returns:
+//   - []Op[T]: A deep copy of the recorded operations.
+func (rt *RecordingTray[T]) Script() []Op[T] {
+	return clone_script(rt.ops)
+}
+
+// ScriptString returns the recorded operations, one per line.
+//
+// Returns:
+//   - string: The recorded operations.
+func (rt *RecordingTray[T]) ScriptString() string {
+	lines := make([]string, 0, len(rt.ops))
+
+	for _, op := range rt.ops {
+		lines = append(lines, op.String())
+	}
+
+	str := strings.Join(lines, "\n")
+
+	return str
+}
+
+// Reset discards the recorded operations.
+func (rt *RecordingTray[T]) Reset() {
+	rt.ops = rt.ops[:0]
+}
+
+// NewRecordingTray creates a new RecordingTray that decorates the given tray.
+//
+// Parameters:
+//   - tray: The tray to decorate.
+//
+// Returns:
+//   - *RecordingTray: A pointer to the new RecordingTray.
+//   - error: An error of type *common.ErrInvalidParameter if tray is nil.
+func NewRecordingTray[T any](tray Trayer[T]) (*RecordingTray[T], error) {
+	if tray == nil {
+		return nil, uc.NewErrNilParameter("tray")
+	}
+
+	rt := &RecordingTray[T]{
+		tray: tray,
+	}
+
+	return rt, nil
+}
+
+// ReplayTray replays a recorded script, one operation at a time, against
+// a tray holding new data. The operations are recorded again while being
+// replayed, so that the observed behavior can be compared with the script.
+type ReplayTray[T any] struct {
+	// tray records the replayed operations.
+	tray *RecordingTray[T]
+
+	// script is the script to replay.
+	script []Op[T]
+
+	// next is the index of the next operation to replay.
+	next int
+}
+
+// apply replays a single operation on the tray.
+//
+// Parameters:
+//   - op: The operation to replay.
+func (rt *ReplayTray[T]) apply(op Op[T]) {
+	switch op.Kind {
+	case OpMove:
+		rt.tray.Move(op.N)
+	case OpWrite:
+		if len(op.Elems) > 0 {
+			_ = rt.tray.Write(op.Elems[0])
+		}
+	case OpRead:
+		_, _ = rt.tray.Read()
+	case OpDelete:
+		rt.tray.Delete(op.N)
+	case OpExtendLeft:
+		rt.tray.ExtendTapeOnLeft(slices.Clone(op.Elems)...)
+	case OpExtendRight:
+		rt.tray.ExtendTapeOnRight(slices.Clone(op.Elems)...)
+	case OpArrowStart:
+		rt.tray.ArrowStart()
+	case OpArrowEnd:
+		rt.tray.ArrowEnd()
+	}
+}
+
+// Step replays the next operation of the script.
+//
+// Returns:
+//   - Op[T]: A copy of the operation as observed on the new data.
+//   - error: An error of type *common.ErrExhaustedIter if the whole script
+//     was replayed.
+func (rt *ReplayTray[T]) Step() (Op[T], error) {
+	if rt.next >= len(rt.script) {
+		return Op[T]{}, uc.NewErrExhaustedIter()
+	}
+
+	rt.apply(rt.script[rt.next])
+	rt.next++
+
+	op := rt.tray.ops[len(rt.tray.ops)-1]
+	op.Elems = slices.Clone(op.Elems)
+
+	return op, nil
+}
+
+// Run replays the rest of the script.
+//
+// Returns:
+//   - []Op[T]: All the operations observed on the new data so far.
+func (rt *ReplayTray[T]) Run() []Op[T] {
+	for rt.next < len(rt.script) {
+		rt.apply(rt.script[rt.next])
+		rt.next++
+	}
+
+	return rt.tray.Script()
+}
+
+// Remaining returns the number of operations left to replay.
+//
+// Returns:
+//   - int: The number of operations left.
+func (rt *ReplayTray[T]) Remaining() int {
+	return len(rt.script) - rt.next
+}
+
+// NewReplayTray creates a new ReplayTray.
+//
+// Parameters:
+//   - tray: The tray holding the new data.
+//   - script: The script to replay. (see RecordingTray.Script)
+//
+// Returns:
+//   - *ReplayTray: A pointer to the new ReplayTray.
+//   - error: An error of type *common.ErrInvalidParameter if tray is nil.
+func NewReplayTray[T any](tray Trayer[T], script []Op[T]) (*ReplayTray[T], error) {
+	recorder, err := NewRecordingTray(tray)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &ReplayTray[T]{
+		tray:   recorder,
+		script: clone_script(script),
+	}
+
+	return rt, nil
+}
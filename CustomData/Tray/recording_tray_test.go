@@ -0,0 +1,95 @@
+package Tray
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	source := NewSimpleTray[int](nil)
+
+	rt, err := NewRecordingTray[int](source)
+	if err != nil {
+		t.Fatalf("NewRecordingTray failed: %s", err.Error())
+	}
+
+	rt.ExtendTapeOnRight(1, 2, 3)
+	_ = rt.Write(99)
+	rt.Move(1)
+	_, _ = rt.Read()
+	rt.Delete(1)
+	rt.ExtendTapeOnLeft(7)
+	rt.ArrowEnd()
+
+	script := rt.Script()
+
+	if !slices.Equal(script[0].Elems, []int{1, 2, 3}) {
+		t.Fatalf("Script failed: expected the extension to record %v, got %v", []int{1, 2, 3}, script[0].Elems)
+	}
+
+	target := NewSimpleTray[int](nil)
+
+	replay, err := NewReplayTray[int](target, script)
+	if err != nil {
+		t.Fatalf("NewReplayTray failed: %s", err.Error())
+	}
+
+	got := replay.Run()
+
+	if len(got) != len(script) {
+		t.Fatalf("Run failed: expected %d operations, got %d", len(script), len(got))
+	}
+
+	for i := range script {
+		if got[i].String() != script[i].String() {
+			t.Errorf("Run failed at %d: expected %q, got %q", i, script[i].String(), got[i].String())
+		}
+	}
+
+	if !slices.Equal(target.tape, source.tape) {
+		t.Errorf("Run failed: expected tape %v, got %v", source.tape, target.tape)
+	}
+
+	// Writing on the replayed tray must not rewrite the script either.
+	target.ArrowStart()
+	_ = target.Write(-1)
+
+	if !slices.Equal(script[0].Elems, []int{1, 2, 3}) {
+		t.Errorf("NewReplayTray failed: the script was modified to %v", script[0].Elems)
+	}
+}
+
+func TestReplayStep(t *testing.T) {
+	script := []Op[int]{
+		{Kind: OpExtendRight, Elems: []int{1, 2, 3}},
+	}
+
+	replay, err := NewReplayTray[int](NewSimpleTray[int](nil), script)
+	if err != nil {
+		t.Fatalf("NewReplayTray failed: %s", err.Error())
+	}
+
+	op, err := replay.Step()
+	if err != nil {
+		t.Fatalf("Step failed: %s", err.Error())
+	}
+
+	op.Elems[0] = -1
+
+	got := replay.Run()
+
+	if !slices.Equal(got[0].Elems, []int{1, 2, 3}) {
+		t.Errorf("Step failed: the recorded script was modified to %v", got[0].Elems)
+	}
+
+	_, err = replay.Step()
+	if err == nil {
+		t.Errorf("Step failed: expected error, got nil")
+	}
+}
+
+func TestOpKindString(t *testing.T) {
+	if str := OpKind(42).String(); str != "OpKind(42)" {
+		t.Errorf("String failed: expected %q, got %q", "OpKind(42)", str)
+	}
+}
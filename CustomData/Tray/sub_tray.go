@@ -0,0 +1,215 @@
+package Tray
+
+import (
+	"slices"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// TrayView is a tray over a region of another tray's tape. It has its own
+// arrow but shares the storage of the tray it was taken from, so no element
+// is copied when the view is created.
+//
+// Behaviors:
+//   - Write is seen by the parent tray (and the other views over the same
+//     region) as long as the view is shared.
+//   - Delete, ExtendTapeOnLeft, and ExtendTapeOnRight copy the region first
+//     (copy-on-write); from then on, the view no longer shares its storage.
+//   - Deleting from or extending the parent tray while a shared view exists
+//     makes the view observe shifted elements; take views of trays whose
+//     layout does not change.
+type TrayView[T any] struct {
+	// tray is the tray over the region.
+	tray *SimpleTray[T]
+
+	// shared is true if the tape of the view is the storage of the parent.
+	shared bool
+}
+
+// detach copies the region so that the view no longer shares its storage.
+func (tv *TrayView[T]) detach() {
+	if !tv.shared {
+		return
+	}
+
+	tape := make([]T, len(tv.tray.tape))
+	copy(tape, tv.tray.tape)
+
+	tv.tray.tape = tape
+	tv.shared = false
+}
+
+// GetLeftDistance implements the Trayer interface.
+func (tv *TrayView[T]) GetLeftDistance() int {
+	return tv.tray.GetLeftDistance()
+}
+
+// GetRightDistance implements the Trayer interface.
+func (tv *TrayView[T]) GetRightDistance() int {
+	return tv.tray.GetRightDistance()
+}
+
+// Move implements the Trayer interface.
+func (tv *TrayView[T]) Move(n int) int {
+	return tv.tray.Move(n)
+}
+
+// Write implements the Trayer interface.
+func (tv *TrayView[T]) Write(elem T) error {
+	return tv.tray.Write(elem)
+}
+
+// Read implements the Trayer interface.
+func (tv *TrayView[T]) Read() (T, error) {
+	return tv.tray.Read()
+}
+
+// ReadMany reads elements around the arrow, as SimpleTray.ReadMany does.
+//
+// Parameters:
+//   - n: The number of positions to read; towards the left if negative.
+//
+// Returns:
+//   - []T: The elements. Never outside the region of the view.
+//
+// Behaviors:
+//   - Unlike SimpleTray.ReadMany, the elements are copied; so the storage of
+//     the parent cannot be modified through them.
+func (tv *TrayView[T]) ReadMany(n int) []T {
+	return slices.Clone(tv.tray.ReadMany(n))
+}
+
+// Delete implements the Trayer interface.
+//
+// The region is copied before the first deletion, so the parent tray is
+// never modified.
+func (tv *TrayView[T]) Delete(n int) int {
+	if n != 0 && tv.tray.size != 0 {
+		tv.detach()
+	}
+
+	return tv.tray.Delete(n)
+}
+
+// ExtendTapeOnLeft implements the Trayer interface.
+//
+// The region is copied before being extended, so the parent tray is never
+// modified.
+func (tv *TrayView[T]) ExtendTapeOnLeft(elems ...T) {
+	if len(elems) == 0 {
+		return
+	}
+
+	tv.detach()
+	tv.tray.ExtendTapeOnLeft(elems...)
+}
+
+// ExtendTapeOnRight implements the Trayer interface.
+//
+// The region is copied before being extended, so the parent tray is never
+// modified.
+func (tv *TrayView[T]) ExtendTapeOnRight(elems ...T) {
+	if len(elems) == 0 {
+		return
+	}
+
+	tv.detach()
+	tv.tray.ExtendTapeOnRight(elems...)
+}
+
+// ArrowStart implements the Trayer interface.
+func (tv *TrayView[T]) ArrowStart() {
+	tv.tray.ArrowStart()
+}
+
+// ArrowEnd implements the Trayer interface.
+func (tv *TrayView[T]) ArrowEnd() {
+	tv.tray.ArrowEnd()
+}
+
+// IsShared checks whether the view still shares the storage of its parent.
+//
+// Returns:
+//   - bool: True if the view is shared, false if it was copied.
+func (tv *TrayView[T]) IsShared() bool {
+	return tv.shared
+}
+
+// SubTray returns a view over a region of the view. (see SimpleTray.SubTray)
+//
+// Parameters:
+//   - from: The index of the first element of the region.
+//   - to: The index after the last element of the region.
+//
+// Returns:
+//   - *TrayView: A pointer to the new view.
+//   - error: An error of type *common.ErrInvalidParameter if the region is
+//     not within the tape.
+func (tv *TrayView[T]) SubTray(from, to int) (*TrayView[T], error) {
+	return new_tray_view(tv.tray.tape, from, to)
+}
+
+// SubTray returns a view over the region [from, to) of the tape. The view has
+// its own arrow, placed at the start of the region, but shares the storage of
+// the tray. (see TrayView)
+//
+// Parameters:
+//   - from: The index of the first element of the region.
+//   - to: The index after the last element of the region.
+//
+// Returns:
+//   - *TrayView: A pointer to the new view.
+//   - error: An error of type *common.ErrInvalidParameter if the region is
+//     not within the tape.
+//
+// Example:
+//
+//	tray := NewSimpleTray([]int{1, 2, 3, 4, 5})
+//
+//	view, _ := tray.SubTray(1, 4)
+//	_ = view.Write(20)
+//
+//	fmt.Println(tray.ReadMany(2)) // [1 20]
+func (t *SimpleTray[T]) SubTray(from, to int) (*TrayView[T], error) {
+	return new_tray_view(t.tape, from, to)
+}
+
+// new_tray_view creates a shared view over the region [from, to) of a tape.
+//
+// Parameters:
+//   - tape: The tape.
+//   - from: The index of the first element of the region.
+//   - to: The index after the last element of the region.
+//
+// Returns:
+//   - *TrayView: A pointer to the new view.
+//   - error: An error of type *common.ErrInvalidParameter if the region is
+//     not within the tape.
+func new_tray_view[T any](tape []T, from, to int) (*TrayView[T], error) {
+	if from < 0 || from > len(tape) {
+		return nil, uc.NewErrInvalidParameter(
+			"from",
+			ers.NewErrOutOfBound(from, 0, len(tape)).WithUpperBound(true),
+		)
+	} else if to < from || to > len(tape) {
+		return nil, uc.NewErrInvalidParameter(
+			"to",
+			ers.NewErrOutOfBound(to, from, len(tape)).WithUpperBound(true),
+		)
+	}
+
+	// The capacity is capped so that appends never write past the region.
+	region := tape[from:to:to]
+
+	tv := &TrayView[T]{
+		tray: &SimpleTray[T]{
+			tape:  region,
+			arrow: 0,
+			size:  len(region),
+		},
+		shared: true,
+	}
+
+	return tv, nil
+}
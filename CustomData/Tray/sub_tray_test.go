@@ -0,0 +1,142 @@
+package Tray
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrayViewRead(t *testing.T) {
+	tray := NewSimpleTray([]int{1, 2, 3, 4, 5})
+
+	view, err := tray.SubTray(1, 4)
+	if err != nil {
+		t.Fatalf("SubTray failed: %s", err.Error())
+	}
+
+	for _, expected := range []int{2, 3, 4} {
+		elem, err := view.Read()
+		if err != nil {
+			t.Fatalf("Read failed: %s", err.Error())
+		}
+
+		if elem != expected {
+			t.Errorf("Read failed: expected %d, got %d", expected, elem)
+		}
+
+		view.Move(1)
+	}
+
+	// The view has its own arrow.
+	if elem, _ := tray.Read(); elem != 1 {
+		t.Errorf("Read failed: expected %d, got %d", 1, elem)
+	}
+
+	_, err = tray.SubTray(2, 6)
+	if err == nil {
+		t.Errorf("SubTray failed: expected error, got nil")
+	}
+}
+
+func TestTrayViewReadMany(t *testing.T) {
+	tray := NewSimpleTray([]int{1, 2, 3, 4, 5})
+
+	view, _ := tray.SubTray(1, 4)
+
+	expected := []int{2, 3}
+
+	// Reads stop at the bounds of the region, not of the parent.
+	got := view.ReadMany(10)
+	if !slices.Equal(got, expected) {
+		t.Errorf("ReadMany failed: expected %v, got %v", expected, got)
+	}
+
+	got[0] = 20
+
+	if elem, _ := view.Read(); elem != 2 {
+		t.Errorf("ReadMany failed: expected a copy, got %d written through", elem)
+	}
+
+	view.ArrowEnd()
+
+	expected = []int{2, 3, 4}
+
+	if got := view.ReadMany(-10); !slices.Equal(got, expected) {
+		t.Errorf("ReadMany failed: expected %v, got %v", expected, got)
+	}
+}
+
+func TestTrayViewCopyOnWrite(t *testing.T) {
+	tray := NewSimpleTray([]int{1, 2, 3, 4, 5})
+
+	view, _ := tray.SubTray(1, 4)
+
+	// Write is shared with the parent.
+	_ = view.Write(20)
+
+	if got := tray.tape; !slices.Equal(got, []int{1, 20, 3, 4, 5}) {
+		t.Errorf("Write failed: expected %v, got %v", []int{1, 20, 3, 4, 5}, got)
+	}
+
+	// Delete copies the region first.
+	view.Delete(1)
+
+	if view.IsShared() {
+		t.Errorf("Delete failed: expected the view to be copied")
+	}
+
+	// Writing after the copy no longer reaches the parent.
+	_ = view.Write(30)
+
+	expected := []int{1, 20, 3, 4, 5}
+
+	if got := tray.tape; !slices.Equal(got, expected) {
+		t.Errorf("Delete failed: expected the parent to be %v, got %v", expected, got)
+	}
+
+	if got := view.tray.tape; !slices.Equal(got, []int{30, 4}) {
+		t.Errorf("Delete failed: expected %v, got %v", []int{30, 4}, got)
+	}
+
+	other, _ := tray.SubTray(0, 2)
+	other.ExtendTapeOnRight(9)
+
+	if other.IsShared() {
+		t.Errorf("ExtendTapeOnRight failed: expected the view to be copied")
+	}
+
+	if got := tray.tape; !slices.Equal(got, expected) {
+		t.Errorf("ExtendTapeOnRight failed: expected the parent to be %v, got %v", expected, got)
+	}
+}
+
+func TestTrayViewMove(t *testing.T) {
+	tray := NewSimpleTray([]int{1, 2, 3, 4, 5})
+
+	view, _ := tray.SubTray(1, 4)
+
+	if excess := view.Move(-1); excess != -1 {
+		t.Errorf("Move failed: expected an excess of %d, got %d", -1, excess)
+	}
+
+	if excess := view.Move(5); excess != 3 {
+		t.Errorf("Move failed: expected an excess of %d, got %d", 3, excess)
+	}
+
+	if elem, _ := view.Read(); elem != 4 {
+		t.Errorf("Move failed: expected %d, got %d", 4, elem)
+	}
+
+	if dist := view.GetRightDistance(); dist != 0 {
+		t.Errorf("GetRightDistance failed: expected %d, got %d", 0, dist)
+	}
+
+	if dist := view.GetLeftDistance(); dist != 2 {
+		t.Errorf("GetLeftDistance failed: expected %d, got %d", 2, dist)
+	}
+
+	empty, _ := tray.SubTray(2, 2)
+
+	if _, err := empty.Read(); err == nil {
+		t.Errorf("Read failed: expected error, got nil")
+	}
+}
@@ -0,0 +1,81 @@
+package Lexer
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ErrNoMatch is an error type for when no rule matches the input.
+type ErrNoMatch struct{}
+
+// Error implements the error interface.
+//
+// Message: "no rule matches the input"
+func (e *ErrNoMatch) Error() string {
+	return "no rule matches the input"
+}
+
+// NewErrNoMatch creates a new ErrNoMatch error.
+//
+// Returns:
+//   - *ErrNoMatch: A pointer to the newly created error.
+func NewErrNoMatch() *ErrNoMatch {
+	e := &ErrNoMatch{}
+	return e
+}
+
+// ErrInvalidLexeme is an error type for when a lexeme cannot be lexed.
+type ErrInvalidLexeme struct {
+	// Lexeme is the offending lexeme.
+	Lexeme string
+
+	// Reason is the reason for the error.
+	Reason error
+}
+
+// Error implements the error interface.
+//
+// Message: "<lexeme>: <reason>"
+//
+// If the reason is nil, the message is "<lexeme> is invalid" instead.
+func (e *ErrInvalidLexeme) Error() string {
+	var builder strings.Builder
+
+	builder.WriteString(strconv.Quote(e.Lexeme))
+
+	if e.Reason == nil {
+		builder.WriteString(" is invalid")
+	} else {
+		builder.WriteString(": ")
+		builder.WriteString(e.Reason.Error())
+	}
+
+	return builder.String()
+}
+
+// Unwrap implements the common.Unwrapper interface.
+func (e *ErrInvalidLexeme) Unwrap() error {
+	return e.Reason
+}
+
+// ChangeReason implements the common.Unwrapper interface.
+func (e *ErrInvalidLexeme) ChangeReason(reason error) {
+	e.Reason = reason
+}
+
+// NewErrInvalidLexeme creates a new ErrInvalidLexeme error.
+//
+// Parameters:
+//   - lexeme: The offending lexeme.
+//   - reason: The reason for the error.
+//
+// Returns:
+//   - *ErrInvalidLexeme: A pointer to the newly created error.
+func NewErrInvalidLexeme(lexeme string, reason error) *ErrInvalidLexeme {
+	e := &ErrInvalidLexeme{
+		Lexeme: lexeme,
+		Reason: reason,
+	}
+
+	return e
+}
@@ -0,0 +1,173 @@
+package Lexer
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	luint "github.com/PlayerR9/lib_units/ints"
+)
+
+// Lexer is a lexer that splits an input into tokens according to a set
+// of rules.
+//
+// At each position, every rule is tried and the longest match wins. When
+// several rules match the same number of runes, the one with the highest
+// priority wins and, if they also share the priority, the one that was
+// added first wins.
+type Lexer struct {
+	// rules are the rules of the lexer in insertion order.
+	rules []*Rule
+}
+
+// NewLexer creates a new lexer with the given rules.
+//
+// Parameters:
+//   - rules: The rules of the lexer.
+//
+// Returns:
+//   - *Lexer: A pointer to the new lexer.
+//
+// Behaviors:
+//   - Nil rules are ignored.
+func NewLexer(rules ...*Rule) *Lexer {
+	l := &Lexer{
+		rules: make([]*Rule, 0, len(rules)),
+	}
+
+	l.AddRules(rules...)
+
+	return l
+}
+
+// AddRules adds rules to the lexer.
+//
+// Parameters:
+//   - rules: The rules to add.
+//
+// Behaviors:
+//   - Nil rules are ignored.
+func (l *Lexer) AddRules(rules ...*Rule) {
+	for _, rule := range rules {
+		if rule != nil {
+			l.rules = append(l.rules, rule)
+		}
+	}
+}
+
+// best_match returns the rule that matches the input at the given index.
+//
+// Parameters:
+//   - input: The input runes.
+//   - at: The index of the first rune to match.
+//
+// Returns:
+//   - *Rule: The winning rule. Nil if no rule matches.
+//   - int: The number of runes matched by the winning rule.
+//   - error: An error if a rule claims to match more runes than there are
+//     left in the input.
+func (l *Lexer) best_match(input []rune, at int) (*Rule, int, error) {
+	var best *Rule
+	var bestLen int
+
+	for _, rule := range l.rules {
+		n := rule.match(input, at)
+		if n <= 0 {
+			continue
+		}
+
+		if n > len(input)-at {
+			err := ers.NewErrOutOfBound(n, 1, len(input)-at).WithUpperBound(true)
+
+			return nil, 0, fmt.Errorf("rule %q matched too many runes: %w", rule.type_, err)
+		}
+
+		if best == nil || n > bestLen || (n == bestLen && rule.priority > best.priority) {
+			best = rule
+			bestLen = n
+		}
+	}
+
+	return best, bestLen, nil
+}
+
+// offending_lexeme returns the lexeme reported when lexing fails at the
+// given index; that is, the runes up to the next space.
+//
+// Parameters:
+//   - input: The input runes.
+//   - at: The index where lexing failed.
+//
+// Returns:
+//   - string: The offending lexeme.
+func offending_lexeme(input []rune, at int) string {
+	end := at + 1
+
+	for end < len(input) && !unicode.IsSpace(input[end]) {
+		end++
+	}
+
+	return string(input[at:end])
+}
+
+// Lex splits the input into tokens.
+//
+// Parameters:
+//   - input: The input to lex.
+//
+// Returns:
+//   - *TokenStream: The tokens that were produced. Never nil.
+//   - error: An error of type *ints.ErrAt, whose index is the column and
+//     whose reason is of type *ErrInvalidLexeme, if no rule matches at some
+//     position or if a rule claims to match past the end of the input.
+//
+// Behaviors:
+//   - On error, the returned stream contains all the tokens produced before
+//     the offending position.
+//   - The offending lexeme spans from the offending position to the next
+//     space, never the rest of the input.
+//   - Tokens produced by skip rules are not added to the stream.
+func (l *Lexer) Lex(input string) (*TokenStream, error) {
+	runes := []rune(input)
+
+	ts := &TokenStream{
+		tokens: make([]*Token, 0),
+	}
+
+	pos := Position{
+		Offset: 0,
+		Line:   1,
+		Column: 1,
+	}
+
+	for pos.Offset < len(runes) {
+		rule, n, err := l.best_match(runes, pos.Offset)
+		if err == nil && rule == nil {
+			err = NewErrNoMatch()
+		}
+
+		if err != nil {
+			lexeme := offending_lexeme(runes, pos.Offset)
+
+			return ts, luint.NewErrAt(
+				pos.Column,
+				"column of line "+strconv.Itoa(pos.Line),
+				NewErrInvalidLexeme(lexeme, err),
+			)
+		}
+
+		lexeme := runes[pos.Offset : pos.Offset+n]
+
+		if !rule.skip {
+			tk := NewToken(rule.type_, string(lexeme), pos)
+			ts.tokens = append(ts.tokens, tk)
+		}
+
+		for _, r := range lexeme {
+			pos = pos.advance(r)
+		}
+	}
+
+	return ts, nil
+}
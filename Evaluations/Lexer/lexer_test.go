@@ -0,0 +1,146 @@
+package Lexer
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	luint "github.com/PlayerR9/lib_units/ints"
+)
+
+func TestLex(t *testing.T) {
+	kw, _ := NewLiteralRule("KEYWORD", "if", 1)
+	id, _ := NewPatternRule("ID", "[a-zA-Z_]\\w*", 0)
+	num, _ := NewCharClassRule("NUMBER", unicode.IsDigit, 0)
+	op, _ := NewPatternRule("OP", "[=<>]=?", 0)
+	ws, _ := NewCharClassRule("WS", unicode.IsSpace, 0)
+
+	l := NewLexer(kw, id, num, op, ws.WithSkip())
+
+	ts, err := l.Lex("if ifx <= 42\nx")
+	if err != nil {
+		t.Fatalf("Lex failed: %s", err.Error())
+	}
+
+	expected := []Token{
+		{Type: "KEYWORD", Lexeme: "if", Pos: Position{Offset: 0, Line: 1, Column: 1}},
+		{Type: "ID", Lexeme: "ifx", Pos: Position{Offset: 3, Line: 1, Column: 4}},
+		{Type: "OP", Lexeme: "<=", Pos: Position{Offset: 7, Line: 1, Column: 8}},
+		{Type: "NUMBER", Lexeme: "42", Pos: Position{Offset: 10, Line: 1, Column: 11}},
+		{Type: "ID", Lexeme: "x", Pos: Position{Offset: 13, Line: 2, Column: 1}},
+	}
+
+	tokens := ts.Tokens()
+	if len(tokens) != len(expected) {
+		t.Fatalf("Lex failed: expected %d tokens, got %d", len(expected), len(tokens))
+	}
+
+	for i, tk := range tokens {
+		if *tk != expected[i] {
+			t.Errorf("Lex failed: expected %s, got %s", expected[i].String(), tk.String())
+		}
+	}
+}
+
+func TestLexError(t *testing.T) {
+	id, _ := NewPatternRule("ID", "[a-z]+", 0)
+	ws, _ := NewPatternRule("WS", "\\s+", 0)
+
+	l := NewLexer(id, ws.WithSkip())
+
+	ts, err := l.Lex("abc\n #!x def")
+
+	var errAt *luint.ErrAt
+
+	ok := errors.As(err, &errAt)
+	if !ok {
+		t.Fatalf("Lex failed: expected *ints.ErrAt, got %v", err)
+	}
+
+	var errLexeme *ErrInvalidLexeme
+
+	ok = errors.As(err, &errLexeme)
+	if !ok || errLexeme.Lexeme != "#!x" || errAt.Index != 2 || errAt.Name != "column of line 2" {
+		t.Errorf("Lex failed: unexpected error %v", err)
+	}
+
+	expected := `2nd column of line 2 is invalid: "#!x": no rule matches the input`
+
+	if err.Error() != expected {
+		t.Errorf("Lex failed: expected %q, got %q", expected, err.Error())
+	}
+
+	if ts.Size() != 1 {
+		t.Errorf("Lex failed: expected 1 token before the error, got %d", ts.Size())
+	}
+}
+
+func TestLexOverlongMatch(t *testing.T) {
+	id, _ := NewPatternRule("ID", "[a-z]+", 0)
+	greedy, _ := NewRule("GREEDY", 0, func(input []rune, at int) int {
+		if input[at] != '#' {
+			return 0
+		}
+
+		return 10
+	})
+
+	l := NewLexer(id, greedy)
+
+	ts, err := l.Lex("ab#c de fg")
+
+	var errAt *luint.ErrAt
+
+	ok := errors.As(err, &errAt)
+	if !ok {
+		t.Fatalf("Lex failed: expected *ints.ErrAt, got %v", err)
+	}
+
+	if !errors.Is(err, ers.ErrOutOfRange) {
+		t.Errorf("Lex failed: expected %v, got %v", ers.ErrOutOfRange, err)
+	}
+
+	var errLexeme *ErrInvalidLexeme
+
+	ok = errors.As(err, &errLexeme)
+	if !ok || errLexeme.Lexeme != "#c" || errAt.Index != 3 {
+		t.Errorf("Lex failed: unexpected error %v", err)
+	}
+
+	if ts.Size() != 1 {
+		t.Errorf("Lex failed: expected 1 token before the error, got %d", ts.Size())
+	}
+}
+
+func TestPatternRule(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    int
+	}{
+		{"a*b", "aaab", 4},
+		{"a*ab", "aaab", 4},
+		{"\\d+\\.\\d+", "3.14x", 4},
+		{"[^\"]*", "abc\"", 3},
+		{"x?y", "y", 1},
+		{"a+", "b", 0},
+	}
+
+	for _, test := range tests {
+		rule, err := NewPatternRule("T", test.pattern, 0)
+		if err != nil {
+			t.Fatalf("NewPatternRule(%q) failed: %s", test.pattern, err.Error())
+		}
+
+		got := rule.match([]rune(test.input), 0)
+		if got != test.want {
+			t.Errorf("pattern %q on %q: expected %d, got %d", test.pattern, test.input, test.want, got)
+		}
+	}
+
+	_, err := NewPatternRule("T", "[abc", 0)
+	if err == nil {
+		t.Errorf("NewPatternRule should fail on unterminated class")
+	}
+}
@@ -0,0 +1,223 @@
+package Lexer
+
+import (
+	"errors"
+	"unicode"
+)
+
+// pattern_item is a single atom of a compiled pattern together with its
+// repetition bounds.
+type pattern_item struct {
+	// accept checks whether the atom matches a rune.
+	accept func(rune) bool
+
+	// min is the minimum number of repetitions.
+	min int
+
+	// max is the maximum number of repetitions. -1 means unbounded.
+	max int
+}
+
+// escape_class returns the predicate associated with an escape sequence.
+//
+// Parameters:
+//   - c: The rune following the backslash.
+//
+// Returns:
+//   - func(rune) bool: The predicate of the escape sequence.
+func escape_class(c rune) func(rune) bool {
+	switch c {
+	case 'd':
+		return unicode.IsDigit
+	case 'w':
+		return func(r rune) bool {
+			return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+		}
+	case 's':
+		return unicode.IsSpace
+	default:
+		return func(r rune) bool {
+			return r == c
+		}
+	}
+}
+
+// compile_class compiles a bracketed character class.
+//
+// Parameters:
+//   - pattern: The pattern.
+//   - i: The index of the rune following the opening bracket.
+//
+// Returns:
+//   - func(rune) bool: The predicate of the class.
+//   - int: The index of the rune following the closing bracket.
+//   - error: An error if the class is not terminated or is malformed.
+func compile_class(pattern []rune, i int) (func(rune) bool, int, error) {
+	var negate bool
+
+	if i < len(pattern) && pattern[i] == '^' {
+		negate = true
+		i++
+	}
+
+	var preds []func(rune) bool
+
+	for i < len(pattern) && (pattern[i] != ']' || len(preds) == 0) {
+		c := pattern[i]
+
+		if c == '\\' {
+			if i+1 >= len(pattern) {
+				return nil, i, errors.New("dangling escape in character class")
+			}
+
+			preds = append(preds, escape_class(pattern[i+1]))
+			i += 2
+
+			continue
+		}
+
+		if i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']' {
+			lo, hi := c, pattern[i+2]
+			if lo > hi {
+				return nil, i, errors.New("invalid range in character class")
+			}
+
+			preds = append(preds, func(r rune) bool {
+				return r >= lo && r <= hi
+			})
+			i += 3
+
+			continue
+		}
+
+		preds = append(preds, func(r rune) bool {
+			return r == c
+		})
+		i++
+	}
+
+	if i >= len(pattern) {
+		return nil, i, errors.New("unterminated character class")
+	}
+
+	f := func(r rune) bool {
+		for _, pred := range preds {
+			if pred(r) {
+				return !negate
+			}
+		}
+
+		return negate
+	}
+
+	return f, i + 1, nil
+}
+
+// compile_pattern compiles a pattern into a sequence of items.
+//
+// Parameters:
+//   - pattern: The pattern to compile.
+//
+// Returns:
+//   - []pattern_item: The compiled items.
+//   - error: An error if the pattern is malformed.
+func compile_pattern(pattern []rune) ([]pattern_item, error) {
+	var items []pattern_item
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		switch c {
+		case '*', '+', '?':
+			if len(items) == 0 || items[len(items)-1].min != 1 || items[len(items)-1].max != 1 {
+				return nil, errors.New("quantifier without a preceding atom")
+			}
+
+			last := &items[len(items)-1]
+
+			switch c {
+			case '*':
+				last.min, last.max = 0, -1
+			case '+':
+				last.min, last.max = 1, -1
+			case '?':
+				last.min, last.max = 0, 1
+			}
+
+			i++
+
+			continue
+		}
+
+		var accept func(rune) bool
+
+		switch c {
+		case '.':
+			accept = func(r rune) bool {
+				return r != '\n'
+			}
+			i++
+		case '\\':
+			if i+1 >= len(pattern) {
+				return nil, errors.New("dangling escape at end of pattern")
+			}
+
+			accept = escape_class(pattern[i+1])
+			i += 2
+		case '[':
+			f, next, err := compile_class(pattern, i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			accept = f
+			i = next
+		default:
+			accept = func(r rune) bool {
+				return r == c
+			}
+			i++
+		}
+
+		items = append(items, pattern_item{
+			accept: accept,
+			min:    1,
+			max:    1,
+		})
+	}
+
+	return items, nil
+}
+
+// match_items matches the compiled items against the input, backtracking
+// over greedy repetitions when needed.
+//
+// Parameters:
+//   - items: The compiled items.
+//   - input: The input runes.
+//   - at: The index of the first rune to match.
+//
+// Returns:
+//   - int: The index after the last matched rune. -1 if there is no match.
+func match_items(items []pattern_item, input []rune, at int) int {
+	if len(items) == 0 {
+		return at
+	}
+
+	item := items[0]
+
+	var count int
+
+	for at+count < len(input) && (item.max < 0 || count < item.max) && item.accept(input[at+count]) {
+		count++
+	}
+
+	for ; count >= item.min; count-- {
+		end := match_items(items[1:], input, at+count)
+		if end >= 0 {
+			return end
+		}
+	}
+
+	return -1
+}
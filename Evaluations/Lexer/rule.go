@@ -0,0 +1,202 @@
+package Lexer
+
+import (
+	"unicode/utf8"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// MatchFunc is a function that matches a rule against the input.
+//
+// Parameters:
+//   - input: The input runes.
+//   - at: The index of the first rune to match.
+//
+// Returns:
+//   - int: The number of runes matched. 0 if the rule does not match.
+type MatchFunc func(input []rune, at int) int
+
+// Rule is a lexing rule that produces tokens of a given type.
+type Rule struct {
+	// type_ is the type of the tokens produced by the rule.
+	type_ string
+
+	// priority is the priority of the rule. When two rules match the same
+	// number of runes, the one with the highest priority wins.
+	priority int
+
+	// skip is true if the tokens produced by the rule are discarded.
+	skip bool
+
+	// match is the function that matches the rule.
+	match MatchFunc
+}
+
+// Type returns the type of the tokens produced by the rule.
+//
+// Returns:
+//   - string: The type of the tokens.
+func (r *Rule) Type() string {
+	return r.type_
+}
+
+// Priority returns the priority of the rule.
+//
+// Returns:
+//   - int: The priority of the rule.
+func (r *Rule) Priority() int {
+	return r.priority
+}
+
+// WithSkip marks the rule so that the tokens it produces are discarded
+// instead of being added to the token stream. Useful for whitespace
+// and comments.
+//
+// Returns:
+//   - *Rule: The rule itself.
+func (r *Rule) WithSkip() *Rule {
+	r.skip = true
+
+	return r
+}
+
+// NewRule creates a new rule that uses a custom match function.
+//
+// Parameters:
+//   - type_: The type of the tokens produced by the rule.
+//   - priority: The priority of the rule.
+//   - match: The match function.
+//
+// Returns:
+//   - *Rule: A pointer to the new rule.
+//   - error: An error of type *common.ErrInvalidParameter if the type
+//     is empty or the match function is nil.
+func NewRule(type_ string, priority int, match MatchFunc) (*Rule, error) {
+	if type_ == "" {
+		return nil, uc.NewErrInvalidParameter("type_", uc.NewErrEmpty("type_"))
+	} else if match == nil {
+		return nil, uc.NewErrNilParameter("match")
+	}
+
+	r := &Rule{
+		type_:    type_,
+		priority: priority,
+		match:    match,
+	}
+
+	return r, nil
+}
+
+// NewLiteralRule creates a new rule that matches the given literal.
+//
+// Parameters:
+//   - type_: The type of the tokens produced by the rule.
+//   - literal: The literal to match.
+//   - priority: The priority of the rule.
+//
+// Returns:
+//   - *Rule: A pointer to the new rule.
+//   - error: An error of type *common.ErrInvalidParameter if the type or
+//     the literal is empty.
+func NewLiteralRule(type_, literal string, priority int) (*Rule, error) {
+	if literal == "" {
+		return nil, uc.NewErrInvalidParameter("literal", uc.NewErrEmpty("literal"))
+	}
+
+	runes := []rune(literal)
+
+	f := func(input []rune, at int) int {
+		if len(input)-at < len(runes) {
+			return 0
+		}
+
+		for i, r := range runes {
+			if input[at+i] != r {
+				return 0
+			}
+		}
+
+		return len(runes)
+	}
+
+	return NewRule(type_, priority, f)
+}
+
+// NewCharClassRule creates a new rule that matches one or more runes
+// that satisfy the given predicate.
+//
+// Parameters:
+//   - type_: The type of the tokens produced by the rule.
+//   - class: The predicate that runes must satisfy. (e.g., unicode.IsDigit)
+//   - priority: The priority of the rule.
+//
+// Returns:
+//   - *Rule: A pointer to the new rule.
+//   - error: An error of type *common.ErrInvalidParameter if the type is
+//     empty or the predicate is nil.
+func NewCharClassRule(type_ string, class func(rune) bool, priority int) (*Rule, error) {
+	if class == nil {
+		return nil, uc.NewErrNilParameter("class")
+	}
+
+	f := func(input []rune, at int) int {
+		var count int
+
+		for at+count < len(input) && class(input[at+count]) {
+			count++
+		}
+
+		return count
+	}
+
+	return NewRule(type_, priority, f)
+}
+
+// NewPatternRule creates a new rule that matches the given pattern.
+//
+// Patterns are a small subset of regular expressions:
+//   - 'c': Matches the rune c.
+//   - '.': Matches any rune except '\n'.
+//   - '[abc]', '[a-z]', '[^abc]': Matches (or not) a set of runes.
+//   - '\d', '\w', '\s': Matches a digit, a word rune, or a space.
+//   - '\c': Matches the rune c literally. (e.g., '\.' or '\[')
+//   - 'x*', 'x+', 'x?': Repeats the previous atom zero or more, one or
+//     more, or zero or one times. Repetitions are greedy.
+//
+// Groups and alternations are not supported; use several rules instead.
+//
+// Parameters:
+//   - type_: The type of the tokens produced by the rule.
+//   - pattern: The pattern to match.
+//   - priority: The priority of the rule.
+//
+// Returns:
+//   - *Rule: A pointer to the new rule.
+//   - error: An error of type *common.ErrInvalidParameter if the type is
+//     empty or the pattern is invalid.
+func NewPatternRule(type_, pattern string, priority int) (*Rule, error) {
+	if pattern == "" {
+		return nil, uc.NewErrInvalidParameter("pattern", uc.NewErrEmpty("pattern"))
+	}
+
+	ok := utf8.ValidString(pattern)
+	if !ok {
+		return nil, uc.NewErrInvalidParameter("pattern", uc.NewErrInvalidRune(nil))
+	}
+
+	items, err := compile_pattern([]rune(pattern))
+	if err != nil {
+		return nil, uc.NewErrInvalidParameter("pattern", err)
+	}
+
+	f := func(input []rune, at int) int {
+		end := match_items(items, input, at)
+		if end < 0 {
+			return 0
+		}
+
+		return end - at
+	}
+
+	return NewRule(type_, priority, f)
+}
@@ -0,0 +1,142 @@
+package Lexer
+
+import (
+	"strconv"
+	"strings"
+
+	tr "github.com/PlayerR9/MyGoLib/CustomData/Tray"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Position represents the position of a lexeme in the input.
+type Position struct {
+	// Offset is the 0-based rune offset from the start of the input.
+	Offset int
+
+	// Line is the 1-based line number.
+	Line int
+
+	// Column is the 1-based column number, counted in runes.
+	Column int
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format: "line <line>, column <column>"
+func (p Position) String() string {
+	values := []string{
+		"line",
+		strconv.Itoa(p.Line) + ",",
+		"column",
+		strconv.Itoa(p.Column),
+	}
+
+	str := strings.Join(values, " ")
+
+	return str
+}
+
+// advance returns the position after reading the given rune.
+//
+// Parameters:
+//   - r: The rune that was read.
+//
+// Returns:
+//   - Position: The new position.
+func (p Position) advance(r rune) Position {
+	p.Offset++
+
+	if r == '\n' {
+		p.Line++
+		p.Column = 1
+	} else {
+		p.Column++
+	}
+
+	return p
+}
+
+// Token represents a token produced by the lexer.
+type Token struct {
+	// Type is the type of the token; that is, the type of the rule that
+	// produced it.
+	Type string
+
+	// Lexeme is the portion of the input that was matched.
+	Lexeme string
+
+	// Pos is the position of the first rune of the lexeme.
+	Pos Position
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format: "<type>(<lexeme>) at line <line>, column <column>"
+func (t *Token) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(t.Type)
+	builder.WriteRune('(')
+	builder.WriteString(strconv.Quote(t.Lexeme))
+	builder.WriteString(") at ")
+	builder.WriteString(t.Pos.String())
+
+	return builder.String()
+}
+
+// NewToken creates a new token.
+//
+// Parameters:
+//   - type_: The type of the token.
+//   - lexeme: The lexeme of the token.
+//   - pos: The position of the token.
+//
+// Returns:
+//   - *Token: A pointer to the new token.
+func NewToken(type_, lexeme string, pos Position) *Token {
+	tk := &Token{
+		Type:   type_,
+		Lexeme: lexeme,
+		Pos:    pos,
+	}
+
+	return tk
+}
+
+// TokenStream is the sequence of tokens produced by a lexer.
+type TokenStream struct {
+	// tokens are the tokens in the stream.
+	tokens []*Token
+}
+
+// Iterator implements the common.Iterable interface.
+func (ts *TokenStream) Iterator() uc.Iterater[*Token] {
+	return uc.NewSimpleIterator(ts.tokens)
+}
+
+// ToTray implements the Tray.Trayable interface.
+func (ts *TokenStream) ToTray() tr.Trayer[*Token] {
+	tokens := make([]*Token, len(ts.tokens))
+	copy(tokens, ts.tokens)
+
+	return tr.NewSimpleTray(tokens)
+}
+
+// Size returns the number of tokens in the stream.
+//
+// Returns:
+//   - int: The number of tokens in the stream.
+func (ts *TokenStream) Size() int {
+	return len(ts.tokens)
+}
+
+// Tokens returns the tokens in the stream.
+//
+// Returns:
+//   - []*Token: A copy of the tokens in the stream.
+func (ts *TokenStream) Tokens() []*Token {
+	tokens := make([]*Token, len(ts.tokens))
+	copy(tokens, ts.tokens)
+
+	return tokens
+}
@@ -0,0 +1,162 @@
+package Concurrent
+
+import (
+	"sync"
+
+	dq "github.com/PlayerR9/MyGoLib/ListLike/Dequer"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// SafeDeque is a decorator that makes any Dequer safe for concurrent use
+// by guarding it with a read/write mutex.
+type SafeDeque[T any] struct {
+	// deque is the decorated deque.
+	deque dq.Dequer[T]
+
+	// mu is the mutex that protects deque.
+	mu sync.RWMutex
+}
+
+// PushFront implements the Dequer interface.
+func (d *SafeDeque[T]) PushFront(value T) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deque.PushFront(value)
+}
+
+// PushBack implements the Dequer interface.
+func (d *SafeDeque[T]) PushBack(value T) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deque.PushBack(value)
+}
+
+// PopFront implements the Dequer interface.
+func (d *SafeDeque[T]) PopFront() (T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deque.PopFront()
+}
+
+// PopBack implements the Dequer interface.
+func (d *SafeDeque[T]) PopBack() (T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deque.PopBack()
+}
+
+// PeekFront implements the Dequer interface.
+func (d *SafeDeque[T]) PeekFront() (T, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.PeekFront()
+}
+
+// PeekBack implements the Dequer interface.
+func (d *SafeDeque[T]) PeekBack() (T, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.PeekBack()
+}
+
+// IsEmpty implements the Dequer interface.
+func (d *SafeDeque[T]) IsEmpty() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.IsEmpty()
+}
+
+// Size implements the Dequer interface.
+func (d *SafeDeque[T]) Size() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.Size()
+}
+
+// Capacity implements the Dequer interface.
+func (d *SafeDeque[T]) Capacity() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.Capacity()
+}
+
+// IsFull implements the Dequer interface.
+func (d *SafeDeque[T]) IsFull() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.IsFull()
+}
+
+// Clear implements the Dequer interface.
+func (d *SafeDeque[T]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deque.Clear()
+}
+
+// Slice implements the Dequer interface.
+func (d *SafeDeque[T]) Slice() []T {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.deque.Slice()
+}
+
+// Iterator implements the Dequer interface.
+//
+// The iterator works on a snapshot of the deque taken under the lock.
+func (d *SafeDeque[T]) Iterator() uc.Iterater[T] {
+	return uc.NewSimpleIterator(d.Slice())
+}
+
+// Do runs a function on the decorated deque while holding the lock, so that
+// several operations (e.g., a PeekBack followed by a PopBack) happen
+// atomically.
+//
+// Parameters:
+//   - f: The function to run. It must not use the SafeDeque itself, or it
+//     deadlocks.
+//
+// Behaviors:
+//   - If f is nil, nothing happens.
+func (d *SafeDeque[T]) Do(f func(deque dq.Dequer[T])) {
+	if f == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	f(d.deque)
+}
+
+// NewSafeDeque creates a new SafeDeque that decorates the given deque.
+//
+// Parameters:
+//   - deque: The deque to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *SafeDeque: A pointer to the new SafeDeque.
+//   - error: An error of type *common.ErrInvalidParameter if deque is nil.
+func NewSafeDeque[T any](deque dq.Dequer[T]) (*SafeDeque[T], error) {
+	if deque == nil {
+		return nil, uc.NewErrNilParameter("deque")
+	}
+
+	d := &SafeDeque[T]{
+		deque: deque,
+	}
+
+	return d, nil
+}
@@ -0,0 +1,137 @@
+package Concurrent
+
+import (
+	"sync"
+
+	qr "github.com/PlayerR9/MyGoLib/ListLike/Queuer"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// SafeQueue is a decorator that makes any Queuer safe for concurrent use
+// by guarding it with a read/write mutex.
+type SafeQueue[T any] struct {
+	// queue is the decorated queue.
+	queue qr.Queuer[T]
+
+	// mu is the mutex that protects queue.
+	mu sync.RWMutex
+}
+
+// Enqueue implements the Queuer interface.
+func (q *SafeQueue[T]) Enqueue(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Enqueue(value)
+}
+
+// Dequeue implements the Queuer interface.
+func (q *SafeQueue[T]) Dequeue() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Dequeue()
+}
+
+// Peek implements the Queuer interface.
+func (q *SafeQueue[T]) Peek() (T, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.Peek()
+}
+
+// IsEmpty implements the Queuer interface.
+func (q *SafeQueue[T]) IsEmpty() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.IsEmpty()
+}
+
+// Size implements the Queuer interface.
+func (q *SafeQueue[T]) Size() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.Size()
+}
+
+// Capacity implements the Queuer interface.
+func (q *SafeQueue[T]) Capacity() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.Capacity()
+}
+
+// IsFull implements the Queuer interface.
+func (q *SafeQueue[T]) IsFull() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.IsFull()
+}
+
+// Clear implements the Queuer interface.
+func (q *SafeQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+}
+
+// Slice implements the Queuer interface.
+func (q *SafeQueue[T]) Slice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.queue.Slice()
+}
+
+// Iterator implements the Queuer interface.
+//
+// The iterator works on a snapshot of the queue taken under the lock.
+func (q *SafeQueue[T]) Iterator() uc.Iterater[T] {
+	return uc.NewSimpleIterator(q.Slice())
+}
+
+// Do runs a function on the decorated queue while holding the lock, so that
+// several operations (e.g., a Peek followed by a Dequeue) happen atomically.
+//
+// Parameters:
+//   - f: The function to run. It must not use the SafeQueue itself, or it
+//     deadlocks.
+//
+// Behaviors:
+//   - If f is nil, nothing happens.
+func (q *SafeQueue[T]) Do(f func(queue qr.Queuer[T])) {
+	if f == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	f(q.queue)
+}
+
+// NewSafeQueue creates a new SafeQueue that decorates the given queue.
+//
+// Parameters:
+//   - queue: The queue to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *SafeQueue: A pointer to the new SafeQueue.
+//   - error: An error of type *common.ErrInvalidParameter if queue is nil.
+func NewSafeQueue[T any](queue qr.Queuer[T]) (*SafeQueue[T], error) {
+	if queue == nil {
+		return nil, uc.NewErrNilParameter("queue")
+	}
+
+	q := &SafeQueue[T]{
+		queue: queue,
+	}
+
+	return q, nil
+}
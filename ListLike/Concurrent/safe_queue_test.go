@@ -0,0 +1,170 @@
+package Concurrent
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	dq "github.com/PlayerR9/MyGoLib/ListLike/Dequer"
+	qr "github.com/PlayerR9/MyGoLib/ListLike/Queuer"
+)
+
+func TestSafeQueue(t *testing.T) {
+	q, err := NewSafeQueue[int](qr.NewLinkedQueue[int]())
+	if err != nil {
+		t.Fatalf("NewSafeQueue failed: %s", err.Error())
+	}
+
+	const producers, count = 4, 200
+
+	var wg sync.WaitGroup
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < count; j++ {
+				_ = q.Enqueue(p*count + j)
+			}
+		}()
+	}
+
+	results := make(chan []int, producers)
+
+	for c := 0; c < producers; c++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var got []int
+
+			for len(got) < count {
+				_, _ = q.Peek()
+				_ = q.Size()
+
+				front, err := q.Dequeue()
+				if err != nil {
+					runtime.Gosched() // Let the producers catch up.
+					continue
+				}
+
+				got = append(got, front)
+			}
+
+			results <- got
+		}()
+	}
+
+	wg.Wait()
+	close(results)
+
+	total := 0
+
+	for got := range results {
+		last := make(map[int]int)
+
+		for _, x := range got {
+			// A consumer sees the elements of each producer in the order
+			// they were enqueued.
+			p := x / count
+			if prev, ok := last[p]; ok && x < prev {
+				t.Errorf("Dequeue failed: %d dequeued after %d", x, prev)
+			}
+
+			last[p] = x
+		}
+
+		total += len(got)
+	}
+
+	if total != producers*count || !q.IsEmpty() {
+		t.Errorf("Dequeue failed: expected %d elements, got %d", producers*count, total)
+	}
+}
+
+func TestSafeQueueSequential(t *testing.T) {
+	_, err := NewSafeQueue[int](nil)
+	if err == nil {
+		t.Errorf("NewSafeQueue failed: expected an error for a nil queue")
+	}
+
+	q, _ := NewSafeQueue[int](qr.NewLinkedQueue(1, 2))
+
+	_ = q.Enqueue(3)
+
+	front, _ := q.Dequeue()
+	if front != 1 {
+		t.Errorf("Dequeue failed: expected %d, got %d", 1, front)
+	}
+
+	q.Do(func(queue qr.Queuer[int]) {
+		front, _ := queue.Peek()
+		if front == 2 {
+			_, _ = queue.Dequeue()
+		}
+	})
+
+	if got := q.Slice(); !slices.Equal(got, []int{3}) {
+		t.Errorf("Do failed: expected %v, got %v", []int{3}, got)
+	}
+}
+
+func TestSafeDeque(t *testing.T) {
+	d, err := NewSafeDeque[int](dq.NewArrayDeque[int]())
+	if err != nil {
+		t.Fatalf("NewSafeDeque failed: %s", err.Error())
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				if j%2 == 0 {
+					_ = d.PushFront(j)
+				} else {
+					_ = d.PushBack(j)
+				}
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				_, _ = d.PeekFront()
+				_, _ = d.PeekBack()
+
+				if j%2 == 0 {
+					_, _ = d.PopFront()
+				} else {
+					_, _ = d.PopBack()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Pops that found the deque empty removed nothing.
+	if size := d.Size(); size < 400 || size > 800 || size != len(d.Slice()) {
+		t.Errorf("Size failed: expected between %d and %d, got %d", 400, 800, size)
+	}
+
+	d.Clear()
+
+	_ = d.PushBack(2)
+	_ = d.PushFront(1)
+
+	if got := d.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Slice failed: expected %v, got %v", []int{1, 2}, got)
+	}
+}
@@ -0,0 +1,173 @@
+package Concurrent
+
+import (
+	"sync"
+
+	stk "github.com/PlayerR9/MyGoLib/ListLike/Stacker"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// SafeStack is a decorator that makes any Stacker safe for concurrent use
+// by guarding it with a read/write mutex.
+type SafeStack[T any] struct {
+	// stack is the decorated stack.
+	stack stk.Stacker[T]
+
+	// mu is the mutex that protects stack.
+	mu sync.RWMutex
+}
+
+// Push implements the Stacker interface.
+func (s *SafeStack[T]) Push(value T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Push(value)
+}
+
+// Pop implements the Stacker interface.
+func (s *SafeStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// Peek implements the Stacker interface.
+func (s *SafeStack[T]) Peek() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *SafeStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *SafeStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Size()
+}
+
+// Capacity implements the Stacker interface.
+func (s *SafeStack[T]) Capacity() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *SafeStack[T]) IsFull() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.IsFull()
+}
+
+// Clear implements the Stacker interface.
+func (s *SafeStack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stack.Clear()
+}
+
+// Slice implements the Stacker interface.
+func (s *SafeStack[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Slice()
+}
+
+// PopWhile implements the Stacker interface.
+//
+// The elements are popped atomically.
+func (s *SafeStack[T]) PopWhile(pred func(T) bool) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.PopWhile(pred)
+}
+
+// PopN implements the Stacker interface.
+//
+// The elements are popped atomically.
+func (s *SafeStack[T]) PopN(n int) ([]T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.PopN(n)
+}
+
+// DrainIterator implements the Stacker interface.
+//
+// Each element is popped under the lock, so several goroutines can drain
+// the same stack; each element is then seen by only one of them.
+func (s *SafeStack[T]) DrainIterator() uc.Iterater[T] {
+	return stk.Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+//
+// The iterator works on a snapshot of the stack taken under the lock.
+func (s *SafeStack[T]) Iterator() uc.Iterater[T] {
+	s.mu.RLock()
+	values := s.stack.Slice()
+	s.mu.RUnlock()
+
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+
+	return uc.NewSimpleIterator(values)
+}
+
+// Do runs a function on the decorated stack while holding the lock, so that
+// several operations (e.g., a Peek followed by a Pop) happen atomically.
+//
+// Parameters:
+//   - f: The function to run. It must not use the SafeStack itself, or it
+//     deadlocks.
+//
+// Behaviors:
+//   - If f is nil, nothing happens.
+func (s *SafeStack[T]) Do(f func(stack stk.Stacker[T])) {
+	if f == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f(s.stack)
+}
+
+// NewSafeStack creates a new SafeStack that decorates the given stack.
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *SafeStack: A pointer to the new SafeStack.
+//   - error: An error of type *common.ErrInvalidParameter if stack is nil.
+func NewSafeStack[T any](stack stk.Stacker[T]) (*SafeStack[T], error) {
+	if stack == nil {
+		return nil, uc.NewErrNilParameter("stack")
+	}
+
+	s := &SafeStack[T]{
+		stack: stack,
+	}
+
+	return s, nil
+}
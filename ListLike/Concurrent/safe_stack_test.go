@@ -0,0 +1,149 @@
+package Concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	stk "github.com/PlayerR9/MyGoLib/ListLike/Stacker"
+)
+
+func TestSafeStack(t *testing.T) {
+	s, err := NewSafeStack[int](stk.NewLinkedStack[int]())
+	if err != nil {
+		t.Fatalf("NewSafeStack failed: %s", err.Error())
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				_ = s.Push(j)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if size := s.Size(); size != 800 {
+		t.Errorf("Size failed: expected %d, got %d", 800, size)
+	}
+}
+
+func TestSafeStackConcurrentPop(t *testing.T) {
+	values := make([]int, 800)
+	for i := range values {
+		values[i] = i
+	}
+
+	s, err := NewSafeStack[int](stk.NewLinkedStack(values...))
+	if err != nil {
+		t.Fatalf("NewSafeStack failed: %s", err.Error())
+	}
+
+	seen := make([][]int, 8)
+
+	var wg sync.WaitGroup
+
+	for i := range seen {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				top, err := s.Pop()
+				if err != nil {
+					return
+				}
+
+				seen[i] = append(seen[i], top)
+			}
+		}()
+
+		// Readers run alongside the writers.
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100 && !s.IsEmpty(); j++ {
+				_, _ = s.Peek()
+
+				if size := s.Size(); size < 0 || size > 800 {
+					t.Errorf("Size failed: got %d", size)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	counts := make(map[int]int)
+
+	for _, popped := range seen {
+		for j := 1; j < len(popped); j++ {
+			if popped[j] >= popped[j-1] {
+				t.Errorf("Pop failed: %d popped after %d by the same goroutine", popped[j], popped[j-1])
+			}
+		}
+
+		for _, x := range popped {
+			counts[x]++
+		}
+	}
+
+	if len(counts) != 800 {
+		t.Errorf("Pop failed: expected %d distinct elements, got %d", 800, len(counts))
+	}
+
+	for x, n := range counts {
+		if n != 1 {
+			t.Errorf("Pop failed: element %d popped %d times", x, n)
+		}
+	}
+}
+
+func TestSafeStackSequential(t *testing.T) {
+	_, err := NewSafeStack[int](nil)
+	if err == nil {
+		t.Errorf("NewSafeStack failed: expected an error for a nil stack")
+	}
+
+	s, _ := NewSafeStack[int](stk.NewLinkedStack(1, 2, 3))
+
+	top, err := s.Peek()
+	if err != nil || top != 3 {
+		t.Errorf("Peek failed: expected %d, got %d", 3, top)
+	}
+
+	iter := s.Iterator()
+
+	first, _ := iter.Consume()
+	if first != 3 {
+		t.Errorf("Iterator failed: expected %d first, got %d", 3, first)
+	}
+
+	popped, ok := s.PopN(2)
+	if !ok || !slices.Equal(popped, []int{3, 2}) {
+		t.Errorf("PopN failed: expected %v, got %v", []int{3, 2}, popped)
+	}
+
+	s.Do(func(stack stk.Stacker[int]) {
+		_ = stack.Push(4)
+	})
+
+	if got := s.Slice(); !slices.Equal(got, []int{1, 4}) {
+		t.Errorf("Do failed: expected %v, got %v", []int{1, 4}, got)
+	}
+
+	s.Clear()
+
+	_, err = s.Pop()
+	if err == nil {
+		t.Errorf("Pop failed: expected an error on an empty stack")
+	}
+}
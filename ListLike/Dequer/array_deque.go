@@ -0,0 +1,203 @@
+package Dequer
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// ArrayDeque is an unbounded deque backed by a circular buffer that doubles
+// in size when full.
+type ArrayDeque[T any] struct {
+	// elems is the circular buffer.
+	elems []T
+
+	// front is the index of the element at the front of the deque.
+	front int
+
+	// size is the number of elements in the deque.
+	size int
+}
+
+// index returns the index in the buffer of the i-th element from the front.
+//
+// Parameters:
+//   - i: The position of the element from the front.
+//
+// Returns:
+//   - int: The index in the buffer.
+func (d *ArrayDeque[T]) index(i int) int {
+	return (d.front + i) % len(d.elems)
+}
+
+// grow makes room for one more element, doubling the size of the buffer if
+// needed.
+func (d *ArrayDeque[T]) grow() {
+	if d.size < len(d.elems) {
+		return
+	}
+
+	elems := make([]T, max(2*len(d.elems), 4))
+
+	for i := 0; i < d.size; i++ {
+		elems[i] = d.elems[d.index(i)]
+	}
+
+	d.elems = elems
+	d.front = 0
+}
+
+// PushFront implements the Dequer interface.
+//
+// Never returns an error.
+func (d *ArrayDeque[T]) PushFront(value T) error {
+	d.grow()
+
+	d.front = (d.front - 1 + len(d.elems)) % len(d.elems)
+	d.elems[d.front] = value
+	d.size++
+
+	return nil
+}
+
+// PushBack implements the Dequer interface.
+//
+// Never returns an error.
+func (d *ArrayDeque[T]) PushBack(value T) error {
+	d.grow()
+
+	d.elems[d.index(d.size)] = value
+	d.size++
+
+	return nil
+}
+
+// PopFront implements the Dequer interface.
+func (d *ArrayDeque[T]) PopFront() (T, error) {
+	if d.size == 0 {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	value := d.elems[d.front]
+	d.elems[d.front] = *new(T) // Help the GC.
+
+	d.front = d.index(1)
+	d.size--
+
+	return value, nil
+}
+
+// PopBack implements the Dequer interface.
+func (d *ArrayDeque[T]) PopBack() (T, error) {
+	if d.size == 0 {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	d.size--
+
+	idx := d.index(d.size)
+
+	value := d.elems[idx]
+	d.elems[idx] = *new(T) // Help the GC.
+
+	return value, nil
+}
+
+// PeekFront implements the Dequer interface.
+func (d *ArrayDeque[T]) PeekFront() (T, error) {
+	if d.size == 0 {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	return d.elems[d.front], nil
+}
+
+// PeekBack implements the Dequer interface.
+func (d *ArrayDeque[T]) PeekBack() (T, error) {
+	if d.size == 0 {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	return d.elems[d.index(d.size-1)], nil
+}
+
+// IsEmpty implements the Dequer interface.
+func (d *ArrayDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size implements the Dequer interface.
+func (d *ArrayDeque[T]) Size() int {
+	return d.size
+}
+
+// Capacity implements the Dequer interface.
+//
+// Always returns -1.
+func (d *ArrayDeque[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Dequer interface.
+//
+// Always returns false.
+func (d *ArrayDeque[T]) IsFull() bool {
+	return false
+}
+
+// Clear implements the Dequer interface.
+func (d *ArrayDeque[T]) Clear() {
+	clear(d.elems)
+
+	d.front = 0
+	d.size = 0
+}
+
+// Slice implements the Dequer interface.
+func (d *ArrayDeque[T]) Slice() []T {
+	slice := make([]T, d.size)
+
+	for i := range slice {
+		slice[i] = d.elems[d.index(i)]
+	}
+
+	return slice
+}
+
+// Iterator implements the Dequer interface.
+//
+// The iterator works on a snapshot of the deque; changes made to the deque
+// afterwards are not seen.
+func (d *ArrayDeque[T]) Iterator() uc.Iterater[T] {
+	return uc.NewSimpleIterator(d.Slice())
+}
+
+// Copy returns a shallow copy of the deque.
+//
+// Returns:
+//   - *ArrayDeque: A copy of the deque.
+func (d *ArrayDeque[T]) Copy() *ArrayDeque[T] {
+	dCopy := &ArrayDeque[T]{
+		elems: d.Slice(),
+		size:  d.size,
+	}
+
+	return dCopy
+}
+
+// NewArrayDeque creates a new ArrayDeque.
+//
+// Parameters:
+//   - values: The initial values, from front to back.
+//
+// Returns:
+//   - *ArrayDeque: A pointer to the new ArrayDeque.
+func NewArrayDeque[T any](values ...T) *ArrayDeque[T] {
+	elems := make([]T, len(values))
+	copy(elems, values)
+
+	d := &ArrayDeque[T]{
+		elems: elems,
+		size:  len(values),
+	}
+
+	return d
+}
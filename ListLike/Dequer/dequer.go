@@ -0,0 +1,97 @@
+package Dequer
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Dequer is an interface for a double-ended queue; that is, a collection
+// whose elements can be added and removed at both ends.
+type Dequer[T any] interface {
+	// PushFront adds an element at the front of the deque.
+	//
+	// Parameters:
+	//   - value: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added. (e.g., the
+	//     deque is full)
+	PushFront(value T) error
+
+	// PushBack adds an element at the back of the deque.
+	//
+	// Parameters:
+	//   - value: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added. (e.g., the
+	//     deque is full)
+	PushBack(value T) error
+
+	// PopFront removes the element at the front of the deque.
+	//
+	// Returns:
+	//   - T: The removed element.
+	//   - error: An error of type *common.ErrEmpty if the deque is empty.
+	PopFront() (T, error)
+
+	// PopBack removes the element at the back of the deque.
+	//
+	// Returns:
+	//   - T: The removed element.
+	//   - error: An error of type *common.ErrEmpty if the deque is empty.
+	PopBack() (T, error)
+
+	// PeekFront returns the element at the front of the deque without
+	// removing it.
+	//
+	// Returns:
+	//   - T: The element at the front of the deque.
+	//   - error: An error of type *common.ErrEmpty if the deque is empty.
+	PeekFront() (T, error)
+
+	// PeekBack returns the element at the back of the deque without
+	// removing it.
+	//
+	// Returns:
+	//   - T: The element at the back of the deque.
+	//   - error: An error of type *common.ErrEmpty if the deque is empty.
+	PeekBack() (T, error)
+
+	// IsEmpty checks if the deque is empty.
+	//
+	// Returns:
+	//   - bool: True if the deque is empty, false otherwise.
+	IsEmpty() bool
+
+	// Size returns the number of elements in the deque.
+	//
+	// Returns:
+	//   - int: The number of elements in the deque.
+	Size() int
+
+	// Capacity returns the maximum number of elements the deque can hold.
+	//
+	// Returns:
+	//   - int: The capacity of the deque, or -1 if it is unbounded.
+	Capacity() int
+
+	// IsFull checks if the deque cannot accept more elements.
+	//
+	// Returns:
+	//   - bool: True if the deque is full, false otherwise. Unbounded deques
+	//     are never full.
+	IsFull() bool
+
+	// Clear removes all the elements from the deque.
+	Clear()
+
+	// Slice returns the elements of the deque, from front to back.
+	//
+	// Returns:
+	//   - []T: A copy of the elements of the deque.
+	Slice() []T
+
+	// Iterator returns an iterator over the elements of the deque, from
+	// front to back.
+	uc.Iterable[T]
+}
@@ -0,0 +1,93 @@
+package Dequer
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeques(t *testing.T) {
+	deques := map[string]Dequer[int]{
+		"LinkedDeque": NewLinkedDeque(2, 3),
+		"ArrayDeque":  NewArrayDeque(2, 3),
+	}
+
+	for name, d := range deques {
+		_ = d.PushFront(1)
+		_ = d.PushBack(4)
+		_ = d.PushBack(5)
+
+		expected := []int{1, 2, 3, 4, 5}
+		if got := d.Slice(); !slices.Equal(got, expected) {
+			t.Errorf("%s failed: expected %v, got %v", name, expected, got)
+		}
+
+		front, _ := d.PopFront()
+		back, _ := d.PopBack()
+
+		if front != 1 || back != 5 {
+			t.Errorf("%s failed: expected 1 and 5, got %d and %d", name, front, back)
+		}
+
+		d.Clear()
+
+		_, err := d.PopBack()
+		if err == nil {
+			t.Errorf("%s failed: expected an error on an empty deque", name)
+		}
+	}
+}
+
+func TestArrayDequeWraparound(t *testing.T) {
+	d := NewArrayDeque(1, 2, 3, 4)
+
+	_, _ = d.PopBack()
+	_, _ = d.PopBack()
+
+	// The front moves back across the start of the buffer.
+	_ = d.PushFront(0)
+	_ = d.PushFront(-1)
+
+	if d.front == 0 || len(d.elems) != 4 {
+		t.Fatalf("PushFront failed: expected a full, wrapped buffer of 4, got front %d and %v", d.front, d.elems)
+	}
+
+	expected := []int{-1, 0, 1, 2}
+	if got := d.Slice(); !slices.Equal(got, expected) {
+		t.Errorf("PushFront failed: expected %v, got %v", expected, got)
+	}
+
+	// The back moves back across the start of the buffer.
+	for _, want := range []int{2, 1, 0} {
+		back, err := d.PopBack()
+		if err != nil || back != want {
+			t.Errorf("PopBack failed: expected %d, got %d, %v", want, back, err)
+		}
+	}
+
+	_ = d.PushBack(3)
+	_ = d.PushBack(4)
+	_ = d.PushBack(5)
+
+	// The buffer is full and wrapped; growing must unwrap it.
+	_ = d.PushFront(-2)
+
+	if len(d.elems) != 8 {
+		t.Errorf("PushFront failed: expected the buffer to grow to %d, got %d", 8, len(d.elems))
+	}
+
+	expected = []int{-2, -1, 3, 4, 5}
+	if got := d.Slice(); !slices.Equal(got, expected) {
+		t.Errorf("PushFront failed: expected %v, got %v", expected, got)
+	}
+
+	for i := len(expected) - 1; i >= 0; i-- {
+		back, err := d.PopBack()
+		if err != nil || back != expected[i] {
+			t.Errorf("PopBack failed: expected %d, got %d, %v", expected[i], back, err)
+		}
+	}
+
+	if !d.IsEmpty() {
+		t.Errorf("PopBack failed: expected an empty deque, got %v", d.Slice())
+	}
+}
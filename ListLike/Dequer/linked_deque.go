@@ -0,0 +1,214 @@
+package Dequer
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// deque_node is a node of a LinkedDeque.
+type deque_node[T any] struct {
+	// value is the value of the node.
+	value T
+
+	// prev is the node towards the front.
+	prev *deque_node[T]
+
+	// next is the node towards the back.
+	next *deque_node[T]
+}
+
+// LinkedDeque is an unbounded deque implemented with a doubly linked list.
+type LinkedDeque[T any] struct {
+	// front is the node at the front of the deque.
+	front *deque_node[T]
+
+	// back is the node at the back of the deque.
+	back *deque_node[T]
+
+	// size is the number of elements in the deque.
+	size int
+}
+
+// PushFront implements the Dequer interface.
+//
+// Never returns an error.
+func (d *LinkedDeque[T]) PushFront(value T) error {
+	node := &deque_node[T]{
+		value: value,
+		next:  d.front,
+	}
+
+	if d.front == nil {
+		d.back = node
+	} else {
+		d.front.prev = node
+	}
+
+	d.front = node
+	d.size++
+
+	return nil
+}
+
+// PushBack implements the Dequer interface.
+//
+// Never returns an error.
+func (d *LinkedDeque[T]) PushBack(value T) error {
+	node := &deque_node[T]{
+		value: value,
+		prev:  d.back,
+	}
+
+	if d.back == nil {
+		d.front = node
+	} else {
+		d.back.next = node
+	}
+
+	d.back = node
+	d.size++
+
+	return nil
+}
+
+// PopFront implements the Dequer interface.
+func (d *LinkedDeque[T]) PopFront() (T, error) {
+	if d.front == nil {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	node := d.front
+
+	d.front = node.next
+	if d.front == nil {
+		d.back = nil
+	} else {
+		d.front.prev = nil
+	}
+
+	d.size--
+
+	node.next = nil // Help the GC.
+
+	return node.value, nil
+}
+
+// PopBack implements the Dequer interface.
+func (d *LinkedDeque[T]) PopBack() (T, error) {
+	if d.back == nil {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	node := d.back
+
+	d.back = node.prev
+	if d.back == nil {
+		d.front = nil
+	} else {
+		d.back.next = nil
+	}
+
+	d.size--
+
+	node.prev = nil // Help the GC.
+
+	return node.value, nil
+}
+
+// PeekFront implements the Dequer interface.
+func (d *LinkedDeque[T]) PeekFront() (T, error) {
+	if d.front == nil {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	return d.front.value, nil
+}
+
+// PeekBack implements the Dequer interface.
+func (d *LinkedDeque[T]) PeekBack() (T, error) {
+	if d.back == nil {
+		return *new(T), uc.NewErrEmpty("deque")
+	}
+
+	return d.back.value, nil
+}
+
+// IsEmpty implements the Dequer interface.
+func (d *LinkedDeque[T]) IsEmpty() bool {
+	return d.front == nil
+}
+
+// Size implements the Dequer interface.
+func (d *LinkedDeque[T]) Size() int {
+	return d.size
+}
+
+// Capacity implements the Dequer interface.
+//
+// Always returns -1.
+func (d *LinkedDeque[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Dequer interface.
+//
+// Always returns false.
+func (d *LinkedDeque[T]) IsFull() bool {
+	return false
+}
+
+// Clear implements the Dequer interface.
+func (d *LinkedDeque[T]) Clear() {
+	d.front = nil
+	d.back = nil
+	d.size = 0
+}
+
+// Slice implements the Dequer interface.
+func (d *LinkedDeque[T]) Slice() []T {
+	slice := make([]T, 0, d.size)
+
+	for node := d.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Iterator implements the Dequer interface.
+//
+// The iterator works on a snapshot of the deque; changes made to the deque
+// afterwards are not seen.
+func (d *LinkedDeque[T]) Iterator() uc.Iterater[T] {
+	return uc.NewSimpleIterator(d.Slice())
+}
+
+// Copy returns a shallow copy of the deque.
+//
+// Returns:
+//   - *LinkedDeque: A copy of the deque.
+func (d *LinkedDeque[T]) Copy() *LinkedDeque[T] {
+	dCopy := new(LinkedDeque[T])
+
+	for node := d.front; node != nil; node = node.next {
+		_ = dCopy.PushBack(node.value)
+	}
+
+	return dCopy
+}
+
+// NewLinkedDeque creates a new LinkedDeque.
+//
+// Parameters:
+//   - values: The initial values, from front to back.
+//
+// Returns:
+//   - *LinkedDeque: A pointer to the new LinkedDeque.
+func NewLinkedDeque[T any](values ...T) *LinkedDeque[T] {
+	d := new(LinkedDeque[T])
+
+	for _, value := range values {
+		_ = d.PushBack(value)
+	}
+
+	return d
+}
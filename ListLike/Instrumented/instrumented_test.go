@@ -0,0 +1,96 @@
+package Instrumented
+
+import (
+	"slices"
+	"testing"
+
+	qr "github.com/PlayerR9/MyGoLib/ListLike/Queuer"
+	stk "github.com/PlayerR9/MyGoLib/ListLike/Stacker"
+)
+
+func TestInstrumentedStack(t *testing.T) {
+	s, err := NewInstrumentedStack[int](stk.NewLinkedStack(1))
+	if err != nil {
+		t.Fatalf("NewInstrumentedStack failed: %s", err.Error())
+	}
+
+	// Nothing is collected until activated.
+	_ = s.Push(2)
+
+	var sizes []int
+
+	s.OnSize(func(size int) { sizes = append(sizes, size) })
+	s.Activate(true)
+
+	_ = s.Push(3)
+	_ = s.Push(4)
+	_, _ = s.Pop()
+	_, _ = s.PopN(2)
+	_, _ = s.Pop()
+	_, _ = s.Pop() // Fails: not recorded.
+
+	expected := Report{Size: 0, MaxSize: 4, Added: 2, Removed: 4}
+	if got := s.Report(); got != expected {
+		t.Errorf("Report failed: expected %v, got %v", expected, got)
+	}
+
+	expectedSizes := []int{3, 4, 3, 1, 0}
+	if !slices.Equal(sizes, expectedSizes) {
+		t.Errorf("OnSize failed: expected %v, got %v", expectedSizes, sizes)
+	}
+
+	s.Activate(false)
+	_ = s.Push(5)
+	s.ResetStats()
+
+	expected = Report{Size: 1}
+	if got := s.Report(); got != expected {
+		t.Errorf("ResetStats failed: expected %v, got %v", expected, got)
+	}
+}
+
+func TestInstrumentedStackOverwrite(t *testing.T) {
+	as, err := stk.NewArrayStack[int](2, stk.OverwriteOldest)
+	if err != nil {
+		t.Fatalf("NewArrayStack failed: %s", err.Error())
+	}
+
+	s, _ := NewInstrumentedStack[int](as)
+	s.Activate(true)
+
+	for i := 0; i < 3; i++ {
+		_ = s.Push(i)
+	}
+
+	// The third push discarded the bottom element.
+	expected := Report{Size: 2, MaxSize: 2, Added: 3, Removed: 1}
+	if got := s.Report(); got != expected {
+		t.Errorf("Report failed: expected %v, got %v", expected, got)
+	}
+}
+
+func TestInstrumentedQueue(t *testing.T) {
+	q, err := NewInstrumentedQueue[int](qr.NewLinkedQueue[int]())
+	if err != nil {
+		t.Fatalf("NewInstrumentedQueue failed: %s", err.Error())
+	}
+
+	q.Activate(true)
+
+	_ = q.Enqueue(1)
+	_ = q.Enqueue(2)
+	_, _ = q.Dequeue()
+	q.Clear()
+
+	report := q.Report()
+
+	expected := "size: 0 (max 2), added: 2, removed: 1, clears: 1"
+	if got := report.String(); got != expected {
+		t.Errorf("String failed: expected %q, got %q", expected, got)
+	}
+
+	_, err = NewInstrumentedQueue[int](nil)
+	if err == nil {
+		t.Errorf("NewInstrumentedQueue failed: expected an error for a nil queue")
+	}
+}
@@ -0,0 +1,106 @@
+package Instrumented
+
+import (
+	qr "github.com/PlayerR9/MyGoLib/ListLike/Queuer"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// InstrumentedQueue is a decorator that collects statistics on any Queuer:
+// the largest size reached, and the number of elements enqueued and
+// dequeued. Collecting is toggled with Activate.
+//
+// An InstrumentedQueue is not safe for concurrent use.
+type InstrumentedQueue[T any] struct {
+	// queue is the decorated queue.
+	queue qr.Queuer[T]
+
+	stats
+}
+
+// Enqueue implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Enqueue(value T) error {
+	err := q.queue.Enqueue(value)
+	if err != nil {
+		return err
+	}
+
+	q.record(1, 0)
+
+	return nil
+}
+
+// Dequeue implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Dequeue() (T, error) {
+	front, err := q.queue.Dequeue()
+	if err != nil {
+		return *new(T), err
+	}
+
+	q.record(0, 1)
+
+	return front, nil
+}
+
+// Peek implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Peek() (T, error) {
+	return q.queue.Peek()
+}
+
+// IsEmpty implements the Queuer interface.
+func (q *InstrumentedQueue[T]) IsEmpty() bool {
+	return q.queue.IsEmpty()
+}
+
+// Size implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Size() int {
+	return q.queue.Size()
+}
+
+// Capacity implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Capacity() int {
+	return q.queue.Capacity()
+}
+
+// IsFull implements the Queuer interface.
+func (q *InstrumentedQueue[T]) IsFull() bool {
+	return q.queue.IsFull()
+}
+
+// Clear implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Clear() {
+	q.queue.Clear()
+	q.record_clear()
+}
+
+// Slice implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Slice() []T {
+	return q.queue.Slice()
+}
+
+// Iterator implements the Queuer interface.
+func (q *InstrumentedQueue[T]) Iterator() uc.Iterater[T] {
+	return q.queue.Iterator()
+}
+
+// NewInstrumentedQueue creates a new InstrumentedQueue that decorates the
+// given queue.
+//
+// Parameters:
+//   - queue: The queue to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *InstrumentedQueue: A pointer to the new InstrumentedQueue. Inactive.
+//   - error: An error of type *common.ErrInvalidParameter if queue is nil.
+func NewInstrumentedQueue[T any](queue qr.Queuer[T]) (*InstrumentedQueue[T], error) {
+	if queue == nil {
+		return nil, uc.NewErrNilParameter("queue")
+	}
+
+	q := &InstrumentedQueue[T]{
+		queue: queue,
+	}
+
+	q.size = queue.Size
+
+	return q, nil
+}
@@ -0,0 +1,135 @@
+package Instrumented
+
+import (
+	stk "github.com/PlayerR9/MyGoLib/ListLike/Stacker"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// InstrumentedStack is a decorator that collects statistics on any Stacker:
+// the largest size reached, and the number of elements pushed and popped.
+// Collecting is toggled with Activate.
+//
+// An InstrumentedStack is not safe for concurrent use; decorate it with a
+// Concurrent.SafeStack if needed.
+type InstrumentedStack[T any] struct {
+	// stack is the decorated stack.
+	stack stk.Stacker[T]
+
+	stats
+}
+
+// Push implements the Stacker interface.
+func (s *InstrumentedStack[T]) Push(value T) error {
+	size := s.stack.Size()
+
+	err := s.stack.Push(value)
+	if err != nil {
+		return err
+	}
+
+	// An OverwriteOldest stack discards its bottom element when full.
+	s.record(1, size+1-s.stack.Size())
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *InstrumentedStack[T]) Pop() (T, error) {
+	top, err := s.stack.Pop()
+	if err != nil {
+		return *new(T), err
+	}
+
+	s.record(0, 1)
+
+	return top, nil
+}
+
+// Peek implements the Stacker interface.
+func (s *InstrumentedStack[T]) Peek() (T, error) {
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *InstrumentedStack[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *InstrumentedStack[T]) Size() int {
+	return s.stack.Size()
+}
+
+// Capacity implements the Stacker interface.
+func (s *InstrumentedStack[T]) Capacity() int {
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *InstrumentedStack[T]) IsFull() bool {
+	return s.stack.IsFull()
+}
+
+// Clear implements the Stacker interface.
+func (s *InstrumentedStack[T]) Clear() {
+	s.stack.Clear()
+	s.record_clear()
+}
+
+// Slice implements the Stacker interface.
+func (s *InstrumentedStack[T]) Slice() []T {
+	return s.stack.Slice()
+}
+
+// PopWhile implements the Stacker interface.
+func (s *InstrumentedStack[T]) PopWhile(pred func(T) bool) []T {
+	popped := s.stack.PopWhile(pred)
+	if len(popped) > 0 {
+		s.record(0, len(popped))
+	}
+
+	return popped
+}
+
+// PopN implements the Stacker interface.
+func (s *InstrumentedStack[T]) PopN(n int) ([]T, bool) {
+	popped, ok := s.stack.PopN(n)
+	if len(popped) > 0 {
+		s.record(0, len(popped))
+	}
+
+	return popped, ok
+}
+
+// DrainIterator implements the Stacker interface.
+func (s *InstrumentedStack[T]) DrainIterator() uc.Iterater[T] {
+	return stk.Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+func (s *InstrumentedStack[T]) Iterator() uc.Iterater[T] {
+	return s.stack.Iterator()
+}
+
+// NewInstrumentedStack creates a new InstrumentedStack that decorates the
+// given stack.
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *InstrumentedStack: A pointer to the new InstrumentedStack. Inactive.
+//   - error: An error of type *common.ErrInvalidParameter if stack is nil.
+func NewInstrumentedStack[T any](stack stk.Stacker[T]) (*InstrumentedStack[T], error) {
+	if stack == nil {
+		return nil, uc.NewErrNilParameter("stack")
+	}
+
+	s := &InstrumentedStack[T]{
+		stack: stack,
+	}
+
+	s.size = stack.Size
+
+	return s, nil
+}
@@ -0,0 +1,153 @@
+package Instrumented
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Report is a snapshot of the statistics of an instrumented container.
+type Report struct {
+	// Size is the number of elements in the container.
+	Size int
+
+	// MaxSize is the largest number of elements the container held while
+	// active.
+	MaxSize int
+
+	// Added is the number of elements added while active. (e.g., pushed or
+	// enqueued)
+	Added int
+
+	// Removed is the number of elements removed while active, Clear aside.
+	// (e.g., popped or dequeued)
+	Removed int
+
+	// Clears is the number of calls to Clear while active.
+	Clears int
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	size: <size> (max <max size>), added: <added>, removed: <removed>, clears: <clears>
+func (r Report) String() string {
+	var builder strings.Builder
+
+	builder.WriteString("size: ")
+	builder.WriteString(strconv.Itoa(r.Size))
+	builder.WriteString(" (max ")
+	builder.WriteString(strconv.Itoa(r.MaxSize))
+	builder.WriteString("), added: ")
+	builder.WriteString(strconv.Itoa(r.Added))
+	builder.WriteString(", removed: ")
+	builder.WriteString(strconv.Itoa(r.Removed))
+	builder.WriteString(", clears: ")
+	builder.WriteString(strconv.Itoa(r.Clears))
+
+	return builder.String()
+}
+
+// stats holds the statistics of an instrumented container.
+type stats struct {
+	// isActive is true if the statistics are being collected.
+	isActive bool
+
+	// report holds the statistics collected so far.
+	report Report
+
+	// size returns the number of elements in the container.
+	size func() int
+
+	// on_size is called with the new size after every change. Nil if none.
+	on_size func(size int)
+}
+
+// Activate starts or stops collecting statistics. Collecting is off by
+// default, so an instrumented container costs almost nothing until then.
+//
+// Parameters:
+//   - active: True to collect statistics, false otherwise.
+//
+// Behaviors:
+//   - The statistics collected so far are kept. (see ResetStats)
+func (s *stats) Activate(active bool) {
+	if active && !s.isActive {
+		s.report.MaxSize = max(s.report.MaxSize, s.size())
+	}
+
+	s.isActive = active
+}
+
+// IsActive checks whether statistics are being collected.
+//
+// Returns:
+//   - bool: True if statistics are being collected, false otherwise.
+func (s *stats) IsActive() bool {
+	return s.isActive
+}
+
+// OnSize sets a function called with the new size of the container after
+// every change made to it while active; for example, to plot the size over
+// time.
+//
+// Parameters:
+//   - f: The function. Nil to remove it.
+func (s *stats) OnSize(f func(size int)) {
+	s.on_size = f
+}
+
+// Report returns the statistics collected so far.
+//
+// Returns:
+//   - Report: The statistics. Size is always the current size, even when
+//     inactive.
+func (s *stats) Report() Report {
+	report := s.report
+	report.Size = s.size()
+
+	return report
+}
+
+// ResetStats discards the statistics collected so far.
+func (s *stats) ResetStats() {
+	s.report = Report{}
+
+	if s.isActive {
+		s.report.MaxSize = s.size()
+	}
+}
+
+// record records a change made to the container.
+//
+// Parameters:
+//   - added: The number of elements added.
+//   - removed: The number of elements removed.
+func (s *stats) record(added, removed int) {
+	if !s.isActive {
+		return
+	}
+
+	size := s.size()
+
+	s.report.Added += added
+	s.report.Removed += removed
+	s.report.MaxSize = max(s.report.MaxSize, size)
+
+	if s.on_size != nil {
+		s.on_size(size)
+	}
+}
+
+// record_clear records a call to Clear.
+func (s *stats) record_clear() {
+	if !s.isActive {
+		return
+	}
+
+	s.report.Clears++
+
+	if s.on_size != nil {
+		s.on_size(0)
+	}
+}
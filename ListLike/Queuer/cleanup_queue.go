@@ -0,0 +1,93 @@
+package Queuer
+
+import (
+	gen "github.com/PlayerR9/MyGoLib/Utility/General"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// CleanupQueue is a decorator that calls Cleanup on the elements removed by
+// Clear.
+//
+// Dequeued elements are handed back to the caller, who owns them from then
+// on; they are not cleaned up.
+type CleanupQueue[T gen.Cleaner] struct {
+	// queue is the decorated queue.
+	queue Queuer[T]
+}
+
+// Enqueue implements the Queuer interface.
+func (q *CleanupQueue[T]) Enqueue(value T) error {
+	return q.queue.Enqueue(value)
+}
+
+// Dequeue implements the Queuer interface.
+func (q *CleanupQueue[T]) Dequeue() (T, error) {
+	return q.queue.Dequeue()
+}
+
+// Peek implements the Queuer interface.
+func (q *CleanupQueue[T]) Peek() (T, error) {
+	return q.queue.Peek()
+}
+
+// IsEmpty implements the Queuer interface.
+func (q *CleanupQueue[T]) IsEmpty() bool {
+	return q.queue.IsEmpty()
+}
+
+// Size implements the Queuer interface.
+func (q *CleanupQueue[T]) Size() int {
+	return q.queue.Size()
+}
+
+// Capacity implements the Queuer interface.
+func (q *CleanupQueue[T]) Capacity() int {
+	return q.queue.Capacity()
+}
+
+// IsFull implements the Queuer interface.
+func (q *CleanupQueue[T]) IsFull() bool {
+	return q.queue.IsFull()
+}
+
+// Clear implements the Queuer interface.
+//
+// The removed elements are cleaned up, from front to back.
+func (q *CleanupQueue[T]) Clear() {
+	values := q.queue.Slice()
+
+	q.queue.Clear()
+
+	gen.CleanupAll(values...)
+}
+
+// Slice implements the Queuer interface.
+func (q *CleanupQueue[T]) Slice() []T {
+	return q.queue.Slice()
+}
+
+// Iterator implements the Queuer interface.
+func (q *CleanupQueue[T]) Iterator() uc.Iterater[T] {
+	return q.queue.Iterator()
+}
+
+// WithAutoCleanup decorates a queue so that the elements removed by Clear
+// are cleaned up automatically. (see CleanupQueue)
+//
+// Parameters:
+//   - queue: The queue to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *CleanupQueue: A pointer to the new CleanupQueue.
+//   - error: An error of type *common.ErrInvalidParameter if queue is nil.
+func WithAutoCleanup[T gen.Cleaner](queue Queuer[T]) (*CleanupQueue[T], error) {
+	if queue == nil {
+		return nil, uc.NewErrNilParameter("queue")
+	}
+
+	q := &CleanupQueue[T]{
+		queue: queue,
+	}
+
+	return q, nil
+}
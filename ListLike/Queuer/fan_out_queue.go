@@ -0,0 +1,316 @@
+package Queuer
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"sync"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// ErrClosed is the error returned when a closed Subscriber is used.
+var ErrClosed error = errors.New("subscriber is closed")
+
+// FanOutQueue is a publish/subscribe queue: every element enqueued is
+// delivered to every subscriber, each of which reads the queue at its own
+// pace through an independent cursor.
+//
+// Delivery is at-least-once: an element is retained until every subscriber
+// acknowledged it, and a subscriber can rewind to redeliver the elements it
+// did not acknowledge yet. A FanOutQueue is safe for concurrent use.
+type FanOutQueue[T any] struct {
+	// elems are the retained elements, from the oldest to the newest.
+	elems []T
+
+	// head is the sequence number of elems[0].
+	head int
+
+	// capacity is the maximum number of retained elements. Not positive if
+	// the retention is unbounded.
+	capacity int
+
+	// subs are the open subscribers.
+	subs map[*Subscriber[T]]struct{}
+
+	// mu is the mutex that protects the queue and its subscribers.
+	mu sync.Mutex
+}
+
+// NewFanOutQueue creates a new, empty, FanOutQueue.
+//
+// Parameters:
+//   - capacity: The maximum number of elements retained for the slowest
+//     subscriber. If not positive, the retention is unbounded.
+//
+// Returns:
+//   - *FanOutQueue[T]: A pointer to the new FanOutQueue.
+func NewFanOutQueue[T any](capacity int) *FanOutQueue[T] {
+	q := &FanOutQueue[T]{
+		capacity: capacity,
+		subs:     make(map[*Subscriber[T]]struct{}),
+	}
+
+	return q
+}
+
+// tail returns the sequence number of the next enqueued element.
+//
+// Returns:
+//   - int: The sequence number.
+func (q *FanOutQueue[T]) tail() int {
+	return q.head + len(q.elems)
+}
+
+// trim drops the elements acknowledged by every subscriber.
+func (q *FanOutQueue[T]) trim() {
+	oldest := q.tail()
+
+	for sub := range q.subs {
+		oldest = min(oldest, sub.acked)
+	}
+
+	n := oldest - q.head
+	if n <= 0 {
+		return
+	}
+
+	q.elems = slices.Delete(q.elems, 0, n)
+	q.head = oldest
+}
+
+// Enqueue adds an element for every current subscriber.
+//
+// Parameters:
+//   - value: The element.
+//
+// Returns:
+//   - error: ers.ErrFull if the retention is full; that is, the slowest
+//     subscriber has capacity elements it did not acknowledge yet.
+//
+// Behaviors:
+//   - If there are no subscribers, the element is dropped.
+func (q *FanOutQueue[T]) Enqueue(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.subs) == 0 {
+		q.head++
+
+		return nil
+	}
+
+	if q.capacity > 0 && len(q.elems) >= q.capacity {
+		return ers.ErrFull
+	}
+
+	q.elems = append(q.elems, value)
+
+	return nil
+}
+
+// Subscribe adds a subscriber whose cursor starts after the last enqueued
+// element.
+//
+// Returns:
+//   - *Subscriber[T]: A pointer to the new subscriber. Close it once done,
+//     or the queue retains every element for it.
+func (q *FanOutQueue[T]) Subscribe() *Subscriber[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	sub := &Subscriber[T]{
+		queue: q,
+		next:  q.tail(),
+		acked: q.tail(),
+	}
+
+	q.subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Size returns the number of retained elements.
+//
+// Returns:
+//   - int: The number of elements not acknowledged by every subscriber.
+func (q *FanOutQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.elems)
+}
+
+// Subscribers returns the number of open subscribers.
+//
+// Returns:
+//   - int: The number of subscribers.
+func (q *FanOutQueue[T]) Subscribers() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.subs)
+}
+
+// MaxLag returns the lag of the slowest subscriber. (see Subscriber.Lag)
+//
+// Returns:
+//   - int: The largest lag. 0 if there are no subscribers.
+func (q *FanOutQueue[T]) MaxLag() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var lag int
+
+	for sub := range q.subs {
+		lag = max(lag, q.tail()-sub.acked)
+	}
+
+	return lag
+}
+
+// Subscriber is a cursor over the elements of a FanOutQueue.
+type Subscriber[T any] struct {
+	// queue is the queue subscribed to.
+	queue *FanOutQueue[T]
+
+	// next is the sequence number of the next element to deliver.
+	next int
+
+	// acked is the sequence number of the first element not acknowledged.
+	acked int
+
+	// closed is true once the subscriber is closed.
+	closed bool
+}
+
+// Receive delivers the next element.
+//
+// Returns:
+//   - T: The element.
+//   - int: The sequence number of the element, to pass to Ack.
+//   - error: An error of type *common.ErrEmpty if there is no element to
+//     deliver, or ErrClosed if the subscriber is closed.
+//
+// Behaviors:
+//   - Receiving an element does not acknowledge it: it is delivered again
+//     after Rewind until it is acknowledged.
+func (s *Subscriber[T]) Receive() (T, int, error) {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if s.closed {
+		return *new(T), 0, ErrClosed
+	} else if s.next >= q.tail() {
+		return *new(T), 0, uc.NewErrEmpty("queue")
+	}
+
+	seq := s.next
+	s.next++
+
+	return q.elems[seq-q.head], seq, nil
+}
+
+// Ack acknowledges every element delivered up to a sequence number, so the
+// queue no longer retains them for this subscriber.
+//
+// Parameters:
+//   - seq: The sequence number of the last element to acknowledge.
+//
+// Returns:
+//   - error: ErrClosed if the subscriber is closed, or an error of type
+//     *common.ErrInvalidParameter if the element was not delivered yet.
+//
+// Behaviors:
+//   - Acknowledging an element already acknowledged is a no-op.
+func (s *Subscriber[T]) Ack(seq int) error {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	} else if seq >= s.next {
+		return uc.NewErrInvalidParameter(
+			"seq",
+			ers.NewErrOutOfBound(seq, math.MinInt, s.next),
+		)
+	}
+
+	if seq < s.acked {
+		return nil
+	}
+
+	s.acked = seq + 1
+	q.trim()
+
+	return nil
+}
+
+// Rewind moves the cursor back to the first element not acknowledged, so
+// that Receive delivers it again.
+func (s *Subscriber[T]) Rewind() {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	s.next = s.acked
+}
+
+// Lag returns the number of elements enqueued but not acknowledged yet.
+//
+// Returns:
+//   - int: The lag. 0 if the subscriber is closed.
+func (s *Subscriber[T]) Lag() int {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if s.closed {
+		return 0
+	}
+
+	return q.tail() - s.acked
+}
+
+// Pending returns the number of elements enqueued but not delivered yet.
+//
+// Returns:
+//   - int: The number of elements Receive can still deliver.
+//     0 if the subscriber is closed.
+func (s *Subscriber[T]) Pending() int {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if s.closed {
+		return 0
+	}
+
+	return q.tail() - s.next
+}
+
+// Close unsubscribes from the queue, so that it no longer retains elements
+// for this subscriber. Closing a closed subscriber is a no-op.
+func (s *Subscriber[T]) Close() {
+	q := s.queue
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+
+	delete(q.subs, s)
+	q.trim()
+}
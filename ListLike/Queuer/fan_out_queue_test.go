@@ -0,0 +1,138 @@
+package Queuer
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+func TestFanOutQueue(t *testing.T) {
+	q := NewFanOutQueue[int](3)
+
+	// Without subscribers, elements are dropped.
+	_ = q.Enqueue(0)
+
+	fast := q.Subscribe()
+	slow := q.Subscribe()
+
+	for i := 1; i <= 3; i++ {
+		err := q.Enqueue(i)
+		if err != nil {
+			t.Fatalf("Enqueue failed: %s", err.Error())
+		}
+	}
+
+	err := q.Enqueue(4)
+	if !errors.Is(err, ers.ErrFull) {
+		t.Errorf("Enqueue failed: expected %v, got %v", ers.ErrFull, err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		value, seq, err := fast.Receive()
+		if err != nil {
+			t.Fatalf("Receive failed: %s", err.Error())
+		}
+
+		if value != i {
+			t.Errorf("Receive failed: expected %d, got %d", i, value)
+		}
+
+		err = fast.Ack(seq)
+		if err != nil {
+			t.Fatalf("Ack failed: %s", err.Error())
+		}
+	}
+
+	// The slow subscriber still holds the elements back.
+	if q.Size() != 3 || q.MaxLag() != 3 || fast.Lag() != 0 {
+		t.Errorf("metrics failed: expected size 3, max lag 3, and lag 0, got %d, %d, and %d", q.Size(), q.MaxLag(), fast.Lag())
+	}
+
+	value, seq, _ := slow.Receive()
+	if value != 1 {
+		t.Errorf("Receive failed: expected %d, got %d", 1, value)
+	}
+
+	err = slow.Ack(seq + 1)
+	if err == nil {
+		t.Errorf("Ack failed: expected error, got nil")
+	}
+
+	// Unacknowledged elements are delivered again after Rewind.
+	slow.Rewind()
+
+	value, seq, _ = slow.Receive()
+	if value != 1 {
+		t.Errorf("Rewind failed: expected %d, got %d", 1, value)
+	}
+
+	_ = slow.Ack(seq)
+
+	if q.Size() != 2 || slow.Lag() != 2 || slow.Pending() != 2 {
+		t.Errorf("Ack failed: expected size 2, lag 2, and pending 2, got %d, %d, and %d", q.Size(), slow.Lag(), slow.Pending())
+	}
+
+	slow.Close()
+
+	if q.Size() != 0 || q.Subscribers() != 1 {
+		t.Errorf("Close failed: expected size 0 and 1 subscriber, got %d and %d", q.Size(), q.Subscribers())
+	}
+
+	_, _, err = slow.Receive()
+	if !errors.Is(err, ErrClosed) {
+		t.Errorf("Receive failed: expected %v, got %v", ErrClosed, err)
+	}
+
+	_, _, err = fast.Receive()
+	if err == nil {
+		t.Errorf("Receive failed: expected error, got nil")
+	}
+}
+
+func TestFanOutQueueConcurrent(t *testing.T) {
+	q := NewFanOutQueue[int](0)
+
+	subs := []*Subscriber[int]{q.Subscribe(), q.Subscribe(), q.Subscribe()}
+
+	var wg sync.WaitGroup
+
+	sums := make([]int, len(subs))
+
+	for i, sub := range subs {
+		wg.Add(1)
+
+		go func(i int, sub *Subscriber[int]) {
+			defer wg.Done()
+
+			for received := 0; received < 100; {
+				value, seq, err := sub.Receive()
+				if err != nil {
+					continue
+				}
+
+				sums[i] += value
+				received++
+
+				_ = sub.Ack(seq)
+			}
+		}(i, sub)
+	}
+
+	for i := 1; i <= 100; i++ {
+		_ = q.Enqueue(i)
+	}
+
+	wg.Wait()
+
+	for i, sum := range sums {
+		if sum != 5050 {
+			t.Errorf("subscriber %d failed: expected sum %d, got %d", i, 5050, sum)
+		}
+	}
+
+	if q.Size() != 0 {
+		t.Errorf("Size failed: expected %d, got %d", 0, q.Size())
+	}
+}
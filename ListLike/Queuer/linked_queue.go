@@ -0,0 +1,172 @@
+package Queuer
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// queue_node is a node of a LinkedQueue.
+type queue_node[T any] struct {
+	// value is the value of the node.
+	value T
+
+	// next is the node towards the back.
+	next *queue_node[T]
+}
+
+// LinkedQueue is an unbounded queue implemented with a singly linked list.
+type LinkedQueue[T any] struct {
+	// front is the node at the front of the queue.
+	front *queue_node[T]
+
+	// back is the node at the back of the queue.
+	back *queue_node[T]
+
+	// size is the number of elements in the queue.
+	size int
+}
+
+// Enqueue implements the Queuer interface.
+//
+// Never returns an error.
+func (q *LinkedQueue[T]) Enqueue(value T) error {
+	node := &queue_node[T]{
+		value: value,
+	}
+
+	if q.back == nil {
+		q.front = node
+	} else {
+		q.back.next = node
+	}
+
+	q.back = node
+	q.size++
+
+	return nil
+}
+
+// Dequeue implements the Queuer interface.
+func (q *LinkedQueue[T]) Dequeue() (T, error) {
+	if q.front == nil {
+		return *new(T), uc.NewErrEmpty("queue")
+	}
+
+	node := q.front
+
+	q.front = node.next
+	if q.front == nil {
+		q.back = nil
+	}
+
+	q.size--
+
+	node.next = nil // Help the GC.
+
+	return node.value, nil
+}
+
+// Peek implements the Queuer interface.
+func (q *LinkedQueue[T]) Peek() (T, error) {
+	if q.front == nil {
+		return *new(T), uc.NewErrEmpty("queue")
+	}
+
+	return q.front.value, nil
+}
+
+// IsEmpty implements the Queuer interface.
+func (q *LinkedQueue[T]) IsEmpty() bool {
+	return q.front == nil
+}
+
+// Size implements the Queuer interface.
+func (q *LinkedQueue[T]) Size() int {
+	return q.size
+}
+
+// Capacity implements the Queuer interface.
+//
+// Always returns -1.
+func (q *LinkedQueue[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Queuer interface.
+//
+// Always returns false.
+func (q *LinkedQueue[T]) IsFull() bool {
+	return false
+}
+
+// Clear implements the Queuer interface.
+func (q *LinkedQueue[T]) Clear() {
+	q.front = nil
+	q.back = nil
+	q.size = 0
+}
+
+// Slice implements the Queuer interface.
+func (q *LinkedQueue[T]) Slice() []T {
+	slice := make([]T, 0, q.size)
+
+	for node := q.front; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// Iterator implements the Queuer interface.
+//
+// The iterator works on a snapshot of the queue; changes made to the queue
+// afterwards are not seen.
+func (q *LinkedQueue[T]) Iterator() uc.Iterater[T] {
+	return uc.NewSimpleIterator(q.Slice())
+}
+
+// NewLinkedQueue creates a new LinkedQueue.
+//
+// Parameters:
+//   - values: The initial values, from front to back.
+//
+// Returns:
+//   - *LinkedQueue: A pointer to the new LinkedQueue.
+func NewLinkedQueue[T any](values ...T) *LinkedQueue[T] {
+	q := new(LinkedQueue[T])
+
+	for _, value := range values {
+		_ = q.Enqueue(value)
+	}
+
+	return q
+}
+
+// NewQueueFromIterator creates a new LinkedQueue with the elements of an
+// iterator.
+//
+// Parameters:
+//   - iter: The iterator. Its elements are enqueued in order; so the first
+//     one ends up at the front of the queue.
+//
+// Returns:
+//   - *LinkedQueue: A pointer to the new LinkedQueue.
+//   - error: An error of type *common.ErrInvalidParameter if iter is nil,
+//     or the error the iterator failed with, if other than exhaustion.
+func NewQueueFromIterator[T any](iter uc.Iterater[T]) (*LinkedQueue[T], error) {
+	if iter == nil {
+		return nil, uc.NewErrNilParameter("iter")
+	}
+
+	q := new(LinkedQueue[T])
+
+	for {
+		value, err := iter.Consume()
+		if uc.IsDone(err) {
+			return q, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		_ = q.Enqueue(value)
+	}
+}
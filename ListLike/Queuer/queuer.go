@@ -0,0 +1,71 @@
+package Queuer
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Queuer is an interface for a first-in, first-out collection.
+type Queuer[T any] interface {
+	// Enqueue adds an element at the back of the queue.
+	//
+	// Parameters:
+	//   - value: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added. (e.g., the
+	//     queue is full)
+	Enqueue(value T) error
+
+	// Dequeue removes the element at the front of the queue.
+	//
+	// Returns:
+	//   - T: The removed element.
+	//   - error: An error of type *common.ErrEmpty if the queue is empty.
+	Dequeue() (T, error)
+
+	// Peek returns the element at the front of the queue without removing
+	// it.
+	//
+	// Returns:
+	//   - T: The element at the front of the queue.
+	//   - error: An error of type *common.ErrEmpty if the queue is empty.
+	Peek() (T, error)
+
+	// IsEmpty checks if the queue is empty.
+	//
+	// Returns:
+	//   - bool: True if the queue is empty, false otherwise.
+	IsEmpty() bool
+
+	// Size returns the number of elements in the queue.
+	//
+	// Returns:
+	//   - int: The number of elements in the queue.
+	Size() int
+
+	// Capacity returns the maximum number of elements the queue can hold.
+	//
+	// Returns:
+	//   - int: The capacity of the queue, or -1 if it is unbounded.
+	Capacity() int
+
+	// IsFull checks if the queue cannot accept more elements.
+	//
+	// Returns:
+	//   - bool: True if the queue is full, false otherwise. Unbounded queues
+	//     are never full.
+	IsFull() bool
+
+	// Clear removes all the elements from the queue.
+	Clear()
+
+	// Slice returns the elements of the queue, from front to back.
+	//
+	// Returns:
+	//   - []T: A copy of the elements of the queue.
+	Slice() []T
+
+	// Iterator returns an iterator over the elements of the queue, from
+	// front to back.
+	uc.Iterable[T]
+}
@@ -0,0 +1,144 @@
+package Queuer
+
+import (
+	"slices"
+	"testing"
+
+	dq "github.com/PlayerR9/MyGoLib/ListLike/Dequer"
+	itr "github.com/PlayerR9/MyGoLib/Utility/Iterators"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+func TestLinkedQueue(t *testing.T) {
+	q := NewLinkedQueue(1, 2)
+
+	_ = q.Enqueue(3)
+
+	front, err := q.Dequeue()
+	if err != nil {
+		t.Fatalf("Dequeue failed: %s", err.Error())
+	} else if front != 1 {
+		t.Errorf("Dequeue failed: expected %d, got %d", 1, front)
+	}
+
+	if got := q.Slice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Slice failed: expected %v, got %v", []int{2, 3}, got)
+	}
+
+	q.Clear()
+
+	_, err = q.Peek()
+	if err == nil {
+		t.Errorf("Peek failed: expected an error on an empty queue")
+	}
+}
+
+func TestUndoableQueue(t *testing.T) {
+	q, err := NewUndoableQueue[int](dq.NewArrayDeque(1))
+	if err != nil {
+		t.Fatalf("NewUndoableQueue failed: %s", err.Error())
+	}
+
+	_ = q.Enqueue(2)
+	_, _ = q.Dequeue()
+	q.Clear()
+
+	_, err = q.Dequeue()
+	if err == nil {
+		t.Errorf("Dequeue failed: expected an error on an empty queue")
+	}
+
+	expected := [][]int{
+		{2},
+		{1, 2},
+		{1},
+	}
+
+	for _, exp := range expected {
+		err := q.Undo()
+		if err != nil {
+			t.Fatalf("Undo failed: %s", err.Error())
+		}
+
+		if got := q.Slice(); !slices.Equal(got, exp) {
+			t.Errorf("Undo failed: expected %v, got %v", exp, got)
+		}
+	}
+
+	_ = q.Redo()
+
+	if got := q.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Redo failed: expected %v, got %v", []int{1, 2}, got)
+	}
+
+	// Enqueueing after an undo keeps the undone operations as a branch.
+	_ = q.Undo()
+	_ = q.Enqueue(5)
+
+	if n := len(q.History().Branches()); n != 2 {
+		t.Errorf("Branches failed: expected %d branches, got %d", 2, n)
+	}
+}
+
+func TestNewQueueFromIterator(t *testing.T) {
+	values := uc.NewSimpleIterator([]int{1, 2, 3, 4})
+	double := func(x int) int { return x * 2 }
+
+	q, err := NewQueueFromIterator(itr.TakeIter(itr.MapIter(values, double), 3))
+	if err != nil {
+		t.Fatalf("NewQueueFromIterator failed: %s", err.Error())
+	}
+
+	expected := []int{2, 4, 6}
+	if got := q.Slice(); !slices.Equal(got, expected) {
+		t.Errorf("NewQueueFromIterator failed: expected %v, got %v", expected, got)
+	}
+
+	front, _ := q.Peek()
+	if front != 2 {
+		t.Errorf("Peek failed: expected %d, got %d", 2, front)
+	}
+
+	// The iterator can be empty.
+	q, err = NewQueueFromIterator(itr.DropIter[int](values, 10))
+	if err != nil || !q.IsEmpty() {
+		t.Errorf("NewQueueFromIterator failed: expected an empty queue, got %v", err)
+	}
+
+	_, err = NewQueueFromIterator[int](nil)
+	if err == nil {
+		t.Errorf("NewQueueFromIterator failed: expected an error for a nil iterator")
+	}
+}
+
+// resource is a value that records whether it was cleaned up.
+type resource struct {
+	cleaned bool
+}
+
+func (r *resource) Cleanup() {
+	r.cleaned = true
+}
+
+func TestWithAutoCleanup(t *testing.T) {
+	q, err := WithAutoCleanup[*resource](NewLinkedQueue[*resource]())
+	if err != nil {
+		t.Fatalf("WithAutoCleanup failed: %s", err.Error())
+	}
+
+	first, second := &resource{}, &resource{}
+
+	_ = q.Enqueue(first)
+	_ = q.Enqueue(second)
+
+	front, _ := q.Dequeue()
+	q.Clear()
+
+	if front.cleaned {
+		t.Errorf("Dequeue failed: expected the dequeued resource not to be cleaned up")
+	}
+
+	if !second.cleaned {
+		t.Errorf("Clear failed: expected the removed resource to be cleaned up")
+	}
+}
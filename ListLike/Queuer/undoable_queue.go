@@ -0,0 +1,193 @@
+package Queuer
+
+import (
+	dq "github.com/PlayerR9/MyGoLib/ListLike/Dequer"
+	dbg "github.com/PlayerR9/MyGoLib/Utility/Debugging"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// UndoableQueue is a queue that records every change made to it in a
+// Debugging.History, so that the changes can be undone and redone.
+//
+// The elements are stored in a Dequer used in first-in, first-out order;
+// having access to both ends lets every operation, Clear aside, be undone
+// in O(1). Only the operations that succeed are recorded.
+type UndoableQueue[T any] struct {
+	// history is the history of the underlying deque.
+	history *dbg.History[dq.Dequer[T]]
+}
+
+// deque returns the underlying deque.
+//
+// Returns:
+//   - dq.Dequer[T]: The underlying deque.
+func (q *UndoableQueue[T]) deque() dq.Dequer[T] {
+	return q.history.GetData()
+}
+
+// Enqueue implements the Queuer interface.
+func (q *UndoableQueue[T]) Enqueue(value T) error {
+	err := q.deque().PushBack(value)
+	if err != nil {
+		return err
+	}
+
+	execute := func(d dq.Dequer[T]) error {
+		return d.PushBack(value)
+	}
+
+	undo := func(d dq.Dequer[T]) error {
+		_, err := d.PopBack()
+		return err
+	}
+
+	q.history.RecordCommand(dbg.NewCommand(execute, undo))
+
+	return nil
+}
+
+// Dequeue implements the Queuer interface.
+func (q *UndoableQueue[T]) Dequeue() (T, error) {
+	front, err := q.deque().PopFront()
+	if err != nil {
+		return *new(T), uc.NewErrEmpty("queue")
+	}
+
+	execute := func(d dq.Dequer[T]) error {
+		_, err := d.PopFront()
+		return err
+	}
+
+	undo := func(d dq.Dequer[T]) error {
+		return d.PushFront(front)
+	}
+
+	q.history.RecordCommand(dbg.NewCommand(execute, undo))
+
+	return front, nil
+}
+
+// Peek implements the Queuer interface.
+func (q *UndoableQueue[T]) Peek() (T, error) {
+	front, err := q.deque().PeekFront()
+	if err != nil {
+		return *new(T), uc.NewErrEmpty("queue")
+	}
+
+	return front, nil
+}
+
+// IsEmpty implements the Queuer interface.
+func (q *UndoableQueue[T]) IsEmpty() bool {
+	return q.deque().IsEmpty()
+}
+
+// Size implements the Queuer interface.
+func (q *UndoableQueue[T]) Size() int {
+	return q.deque().Size()
+}
+
+// Capacity implements the Queuer interface.
+func (q *UndoableQueue[T]) Capacity() int {
+	return q.deque().Capacity()
+}
+
+// IsFull implements the Queuer interface.
+func (q *UndoableQueue[T]) IsFull() bool {
+	return q.deque().IsFull()
+}
+
+// Clear implements the Queuer interface.
+//
+// Clearing an empty queue is not recorded.
+func (q *UndoableQueue[T]) Clear() {
+	d := q.deque()
+	if d.IsEmpty() {
+		return
+	}
+
+	snapshot := d.Slice()
+	d.Clear()
+
+	execute := func(d dq.Dequer[T]) error {
+		d.Clear()
+		return nil
+	}
+
+	undo := func(d dq.Dequer[T]) error {
+		d.Clear()
+
+		for _, value := range snapshot {
+			err := d.PushBack(value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	q.history.RecordCommand(dbg.NewCommand(execute, undo))
+}
+
+// Slice implements the Queuer interface.
+func (q *UndoableQueue[T]) Slice() []T {
+	return q.deque().Slice()
+}
+
+// Iterator implements the Queuer interface.
+func (q *UndoableQueue[T]) Iterator() uc.Iterater[T] {
+	return q.deque().Iterator()
+}
+
+// Undo undoes the last recorded operation.
+//
+// Returns:
+//   - error: An error if the undo fails.
+//
+// Behaviors:
+//   - If there are no operations to undo, no action is taken.
+func (q *UndoableQueue[T]) Undo() error {
+	return q.history.UndoLastCommand()
+}
+
+// Redo executes again the last undone operation.
+//
+// Returns:
+//   - error: An error if the execution fails.
+//
+// Behaviors:
+//   - If there are no operations to redo, no action is taken.
+func (q *UndoableQueue[T]) Redo() error {
+	return q.history.Redo()
+}
+
+// History returns the history of the queue; for example, to switch
+// between the branches left by undone operations.
+//
+// Returns:
+//   - *Debugging.History[Dequer.Dequer[T]]: The history. Never nil.
+func (q *UndoableQueue[T]) History() *dbg.History[dq.Dequer[T]] {
+	return q.history
+}
+
+// NewUndoableQueue creates a new UndoableQueue.
+//
+// Parameters:
+//   - deque: The deque that stores the elements, from front to back. It
+//     must not be used directly afterwards.
+//
+// Returns:
+//   - *UndoableQueue: A pointer to the new UndoableQueue.
+//   - error: An error of type *common.ErrInvalidParameter if deque is nil.
+func NewUndoableQueue[T any](deque dq.Dequer[T]) (*UndoableQueue[T], error) {
+	if deque == nil {
+		return nil, uc.NewErrNilParameter("deque")
+	}
+
+	q := &UndoableQueue[T]{
+		history: dbg.NewHistory(deque),
+	}
+
+	return q, nil
+}
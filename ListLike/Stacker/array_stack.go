@@ -0,0 +1,244 @@
+package Stacker
+
+import (
+	"math"
+	"strconv"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// OverflowPolicy is an enum that tells an ArrayStack what to do when an
+// element is pushed onto a full stack.
+type OverflowPolicy int8
+
+const (
+	// RejectOnFull makes Push fail with ErrFull.
+	RejectOnFull OverflowPolicy = iota
+
+	// OverwriteOldest discards the element at the bottom of the stack to
+	// make room for the new one.
+	OverwriteOldest
+
+	// GrowOnFull doubles the storage of the stack; the stack is thus
+	// unbounded.
+	GrowOnFull
+)
+
+// String implements the fmt.Stringer interface.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case RejectOnFull:
+		return "reject on full"
+	case OverwriteOldest:
+		return "overwrite oldest"
+	case GrowOnFull:
+		return "grow on full"
+	default:
+		return "OverflowPolicy(" + strconv.Itoa(int(p)) + ")"
+	}
+}
+
+// ArrayStack is a stack backed by a fixed-size circular buffer.
+type ArrayStack[T any] struct {
+	// elems is the circular buffer.
+	elems []T
+
+	// bottom is the index of the element at the bottom of the stack.
+	bottom int
+
+	// size is the number of elements in the stack.
+	size int
+
+	// policy is the overflow policy of the stack.
+	policy OverflowPolicy
+}
+
+// index returns the index in the buffer of the i-th element from the bottom.
+//
+// Parameters:
+//   - i: The position of the element from the bottom.
+//
+// Returns:
+//   - int: The index in the buffer.
+func (s *ArrayStack[T]) index(i int) int {
+	return (s.bottom + i) % len(s.elems)
+}
+
+// grow doubles the size of the buffer and moves the bottom of the stack to
+// the start of the buffer.
+func (s *ArrayStack[T]) grow() {
+	elems := make([]T, 2*len(s.elems))
+
+	n := copy(elems, s.elems[s.bottom:])
+	copy(elems[n:], s.elems[:s.bottom])
+
+	s.elems = elems
+	s.bottom = 0
+}
+
+// Push implements the Stacker interface.
+//
+// Errors:
+//   - ers.ErrFull: The stack is full and its policy is RejectOnFull.
+func (s *ArrayStack[T]) Push(value T) error {
+	if s.size == len(s.elems) {
+		switch s.policy {
+		case RejectOnFull:
+			return ers.ErrFull
+		case OverwriteOldest:
+			s.elems[s.bottom] = value
+			s.bottom = s.index(1)
+
+			return nil
+		case GrowOnFull:
+			s.grow()
+		}
+	}
+
+	s.elems[s.index(s.size)] = value
+	s.size++
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *ArrayStack[T]) Pop() (T, error) {
+	if s.size == 0 {
+		return *new(T), uc.NewErrEmpty("stack")
+	}
+
+	s.size--
+
+	idx := s.index(s.size)
+
+	top := s.elems[idx]
+	s.elems[idx] = *new(T) // Help the GC.
+
+	return top, nil
+}
+
+// Peek implements the Stacker interface.
+func (s *ArrayStack[T]) Peek() (T, error) {
+	if s.size == 0 {
+		return *new(T), uc.NewErrEmpty("stack")
+	}
+
+	return s.elems[s.index(s.size-1)], nil
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *ArrayStack[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Size implements the Stacker interface.
+func (s *ArrayStack[T]) Size() int {
+	return s.size
+}
+
+// Capacity implements the Stacker interface.
+//
+// Returns -1 if the policy is GrowOnFull.
+func (s *ArrayStack[T]) Capacity() int {
+	if s.policy == GrowOnFull {
+		return -1
+	}
+
+	return len(s.elems)
+}
+
+// IsFull implements the Stacker interface.
+//
+// Always returns false if the policy is GrowOnFull.
+func (s *ArrayStack[T]) IsFull() bool {
+	return s.policy != GrowOnFull && s.size == len(s.elems)
+}
+
+// Clear implements the Stacker interface.
+func (s *ArrayStack[T]) Clear() {
+	clear(s.elems)
+
+	s.bottom = 0
+	s.size = 0
+}
+
+// Slice implements the Stacker interface.
+func (s *ArrayStack[T]) Slice() []T {
+	slice := make([]T, s.size)
+
+	for i := range slice {
+		slice[i] = s.elems[s.index(i)]
+	}
+
+	return slice
+}
+
+// PopWhile implements the Stacker interface.
+func (s *ArrayStack[T]) PopWhile(pred func(T) bool) []T {
+	return pop_while[T](s, pred)
+}
+
+// PopN implements the Stacker interface.
+func (s *ArrayStack[T]) PopN(n int) ([]T, bool) {
+	return pop_n[T](s, n)
+}
+
+// DrainIterator implements the Stacker interface.
+func (s *ArrayStack[T]) DrainIterator() uc.Iterater[T] {
+	return Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+//
+// The iterator works on a snapshot of the stack; changes made to the stack
+// afterwards are not seen.
+func (s *ArrayStack[T]) Iterator() uc.Iterater[T] {
+	values := make([]T, s.size)
+
+	for i := range values {
+		values[i] = s.elems[s.index(s.size-1-i)]
+	}
+
+	return uc.NewSimpleIterator(values)
+}
+
+// Policy returns the overflow policy of the stack.
+//
+// Returns:
+//   - OverflowPolicy: The overflow policy.
+func (s *ArrayStack[T]) Policy() OverflowPolicy {
+	return s.policy
+}
+
+// NewArrayStack creates a new ArrayStack.
+//
+// Parameters:
+//   - capacity: The capacity of the stack. With GrowOnFull, this is only
+//     the initial size of the buffer.
+//   - policy: What to do when pushing onto a full stack.
+//
+// Returns:
+//   - *ArrayStack: A pointer to the new ArrayStack.
+//   - error: An error of type *common.ErrInvalidParameter if capacity is not
+//     positive or policy is not a valid OverflowPolicy.
+func NewArrayStack[T any](capacity int, policy OverflowPolicy) (*ArrayStack[T], error) {
+	if capacity <= 0 {
+		return nil, uc.NewErrInvalidParameter(
+			"capacity",
+			ers.NewErrOutOfBound(capacity, 0, math.MaxInt).WithLowerBound(false),
+		)
+	} else if policy < RejectOnFull || policy > GrowOnFull {
+		return nil, uc.NewErrInvalidParameter(
+			"policy",
+			ers.NewErrOutOfBound(int(policy), int(RejectOnFull), int(GrowOnFull)).WithUpperBound(true),
+		)
+	}
+
+	s := &ArrayStack[T]{
+		elems:  make([]T, capacity),
+		policy: policy,
+	}
+
+	return s, nil
+}
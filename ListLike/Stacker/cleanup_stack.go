@@ -0,0 +1,129 @@
+package Stacker
+
+import (
+	gen "github.com/PlayerR9/MyGoLib/Utility/General"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// CleanupStack is a decorator that calls Cleanup on the elements that the
+// decorated stack discards; that is, the elements removed by Clear and the
+// bottom elements overwritten by an ArrayStack with OverwriteOldest.
+//
+// Popped elements are handed back to the caller, who owns them from then
+// on; they are not cleaned up.
+type CleanupStack[T gen.Cleaner] struct {
+	// stack is the decorated stack.
+	stack Stacker[T]
+}
+
+// Push implements the Stacker interface.
+//
+// If the decorated stack discards its bottom element to make room, that
+// element is cleaned up.
+func (s *CleanupStack[T]) Push(value T) error {
+	var bottom []T
+
+	if s.stack.IsFull() {
+		bottom = s.stack.Slice()[:1]
+	}
+
+	size := s.stack.Size()
+
+	err := s.stack.Push(value)
+	if err != nil {
+		return err
+	}
+
+	if len(bottom) > 0 && s.stack.Size() == size {
+		gen.CleanupAll(bottom...)
+	}
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *CleanupStack[T]) Pop() (T, error) {
+	return s.stack.Pop()
+}
+
+// Peek implements the Stacker interface.
+func (s *CleanupStack[T]) Peek() (T, error) {
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *CleanupStack[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *CleanupStack[T]) Size() int {
+	return s.stack.Size()
+}
+
+// Capacity implements the Stacker interface.
+func (s *CleanupStack[T]) Capacity() int {
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *CleanupStack[T]) IsFull() bool {
+	return s.stack.IsFull()
+}
+
+// Clear implements the Stacker interface.
+//
+// The removed elements are cleaned up, from bottom to top.
+func (s *CleanupStack[T]) Clear() {
+	values := s.stack.Slice()
+
+	s.stack.Clear()
+
+	gen.CleanupAll(values...)
+}
+
+// Slice implements the Stacker interface.
+func (s *CleanupStack[T]) Slice() []T {
+	return s.stack.Slice()
+}
+
+// PopWhile implements the Stacker interface.
+func (s *CleanupStack[T]) PopWhile(pred func(T) bool) []T {
+	return s.stack.PopWhile(pred)
+}
+
+// PopN implements the Stacker interface.
+func (s *CleanupStack[T]) PopN(n int) ([]T, bool) {
+	return s.stack.PopN(n)
+}
+
+// DrainIterator implements the Stacker interface.
+func (s *CleanupStack[T]) DrainIterator() uc.Iterater[T] {
+	return s.stack.DrainIterator()
+}
+
+// Iterator implements the Stacker interface.
+func (s *CleanupStack[T]) Iterator() uc.Iterater[T] {
+	return s.stack.Iterator()
+}
+
+// WithAutoCleanup decorates a stack so that the elements it discards are
+// cleaned up automatically. (see CleanupStack)
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *CleanupStack: A pointer to the new CleanupStack.
+//   - error: An error of type *common.ErrInvalidParameter if stack is nil.
+func WithAutoCleanup[T gen.Cleaner](stack Stacker[T]) (*CleanupStack[T], error) {
+	if stack == nil {
+		return nil, uc.NewErrNilParameter("stack")
+	}
+
+	s := &CleanupStack[T]{
+		stack: stack,
+	}
+
+	return s, nil
+}
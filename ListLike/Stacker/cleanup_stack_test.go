@@ -0,0 +1,54 @@
+package Stacker
+
+import (
+	"testing"
+)
+
+// resource is a value that records whether it was cleaned up.
+type resource struct {
+	id      int
+	cleaned bool
+}
+
+func (r *resource) Cleanup() {
+	r.cleaned = true
+}
+
+func TestWithAutoCleanup(t *testing.T) {
+	as, err := NewArrayStack[*resource](2, OverwriteOldest)
+	if err != nil {
+		t.Fatalf("NewArrayStack failed: %s", err.Error())
+	}
+
+	s, err := WithAutoCleanup[*resource](as)
+	if err != nil {
+		t.Fatalf("WithAutoCleanup failed: %s", err.Error())
+	}
+
+	res := []*resource{{id: 0}, {id: 1}, {id: 2}, {id: 3}}
+
+	for _, r := range res[:3] {
+		_ = s.Push(r)
+	}
+
+	// The first resource was overwritten.
+	if !res[0].cleaned || res[1].cleaned {
+		t.Errorf("Push failed: expected only resource 0 to be cleaned up")
+	}
+
+	top, _ := s.Pop()
+	if top.cleaned {
+		t.Errorf("Pop failed: expected the popped resource not to be cleaned up")
+	}
+
+	_ = s.Push(res[3])
+	_ = s.Push(nil) // Overwrites res[1]; nil values are skipped on Clear.
+
+	s.Clear()
+
+	for _, r := range res {
+		if r != top && !r.cleaned {
+			t.Errorf("Clear failed: expected resource %d to be cleaned up", r.id)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package Stacker
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// extremum_stack is a decorator that keeps track of the best element of a
+// stack; that is, the smallest one for MinStack and the largest one for
+// MaxStack.
+type extremum_stack[T any] struct {
+	// stack is the decorated stack.
+	stack Stacker[T]
+
+	// better checks whether a is strictly better than b.
+	better func(a, b T) bool
+
+	// bests are the best elements of the stack: bests[i] is the best of the
+	// i+1 elements at the bottom of the stack.
+	bests []T
+}
+
+// rebuild recomputes the best elements from the contents of the stack.
+func (s *extremum_stack[T]) rebuild() {
+	s.bests = s.bests[:0]
+
+	for _, value := range s.stack.Slice() {
+		s.append_best(value)
+	}
+}
+
+// append_best records the best element once a value is pushed.
+//
+// Parameters:
+//   - value: The pushed value.
+func (s *extremum_stack[T]) append_best(value T) {
+	if len(s.bests) > 0 {
+		best := s.bests[len(s.bests)-1]
+
+		if !s.better(value, best) {
+			value = best
+		}
+	}
+
+	s.bests = append(s.bests, value)
+}
+
+// best returns the best element of the stack.
+//
+// Returns:
+//   - T: The best element.
+//   - error: An error of type *common.ErrEmpty if the stack is empty.
+func (s *extremum_stack[T]) best() (T, error) {
+	if len(s.bests) == 0 {
+		return *new(T), uc.NewErrEmpty("stack")
+	}
+
+	return s.bests[len(s.bests)-1], nil
+}
+
+// Push implements the Stacker interface.
+//
+// Behaviors:
+//   - If the decorated stack discards its bottom element to make room (see
+//     OverwriteOldest), the best elements are recomputed in O(n).
+func (s *extremum_stack[T]) Push(value T) error {
+	err := s.stack.Push(value)
+	if err != nil {
+		return err
+	}
+
+	if s.stack.Size() == len(s.bests)+1 {
+		s.append_best(value)
+	} else {
+		s.rebuild()
+	}
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *extremum_stack[T]) Pop() (T, error) {
+	top, err := s.stack.Pop()
+	if err != nil {
+		return *new(T), err
+	}
+
+	s.bests[len(s.bests)-1] = *new(T) // Help the GC.
+	s.bests = s.bests[:len(s.bests)-1]
+
+	return top, nil
+}
+
+// Peek implements the Stacker interface.
+func (s *extremum_stack[T]) Peek() (T, error) {
+	return s.stack.Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *extremum_stack[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *extremum_stack[T]) Size() int {
+	return s.stack.Size()
+}
+
+// Capacity implements the Stacker interface.
+func (s *extremum_stack[T]) Capacity() int {
+	return s.stack.Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *extremum_stack[T]) IsFull() bool {
+	return s.stack.IsFull()
+}
+
+// Clear implements the Stacker interface.
+func (s *extremum_stack[T]) Clear() {
+	s.stack.Clear()
+
+	clear(s.bests)
+	s.bests = s.bests[:0]
+}
+
+// Slice implements the Stacker interface.
+func (s *extremum_stack[T]) Slice() []T {
+	return s.stack.Slice()
+}
+
+// PopWhile implements the Stacker interface.
+func (s *extremum_stack[T]) PopWhile(pred func(T) bool) []T {
+	return pop_while[T](s, pred)
+}
+
+// PopN implements the Stacker interface.
+func (s *extremum_stack[T]) PopN(n int) ([]T, bool) {
+	return pop_n[T](s, n)
+}
+
+// DrainIterator implements the Stacker interface.
+func (s *extremum_stack[T]) DrainIterator() uc.Iterater[T] {
+	return Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+func (s *extremum_stack[T]) Iterator() uc.Iterater[T] {
+	return s.stack.Iterator()
+}
+
+// new_extremum_stack creates a new extremum_stack.
+//
+// Parameters:
+//   - stack: The stack to decorate.
+//   - compare: The comparison function.
+//   - sign: -1 to keep track of the smallest element, 1 for the largest.
+//
+// Returns:
+//   - extremum_stack[T]: The new extremum_stack.
+//   - error: An error of type *common.ErrInvalidParameter if stack or
+//     compare is nil.
+func new_extremum_stack[T any](stack Stacker[T], compare func(a, b T) int, sign int) (extremum_stack[T], error) {
+	if stack == nil {
+		return extremum_stack[T]{}, uc.NewErrNilParameter("stack")
+	} else if compare == nil {
+		return extremum_stack[T]{}, uc.NewErrNilParameter("compare")
+	}
+
+	s := extremum_stack[T]{
+		stack: stack,
+		better: func(a, b T) bool {
+			return compare(a, b)*sign > 0
+		},
+	}
+
+	s.rebuild()
+
+	return s, nil
+}
+
+// MinStack is a decorator that makes the smallest element of any Stacker
+// available in O(1), at the cost of O(n) extra memory.
+type MinStack[T any] struct {
+	extremum_stack[T]
+}
+
+// Min returns the smallest element of the stack.
+//
+// Returns:
+//   - T: The smallest element. If several elements are equal, the bottom
+//     most one.
+//   - error: An error of type *common.ErrEmpty if the stack is empty.
+func (s *MinStack[T]) Min() (T, error) {
+	return s.best()
+}
+
+// NewMinStack creates a new MinStack that decorates the given stack.
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//   - compare: The comparison function. (e.g., cmp.Compare)
+//
+// Returns:
+//   - *MinStack: A pointer to the new MinStack.
+//   - error: An error of type *common.ErrInvalidParameter if stack or
+//     compare is nil.
+//
+// Example:
+//
+//	s, _ := NewMinStack[int](NewLinkedStack(3, 1, 2), cmp.Compare[int])
+//	min, _ := s.Min() // 1
+func NewMinStack[T any](stack Stacker[T], compare func(a, b T) int) (*MinStack[T], error) {
+	es, err := new_extremum_stack(stack, compare, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinStack[T]{es}, nil
+}
+
+// MaxStack is a decorator that makes the largest element of any Stacker
+// available in O(1), at the cost of O(n) extra memory.
+type MaxStack[T any] struct {
+	extremum_stack[T]
+}
+
+// Max returns the largest element of the stack.
+//
+// Returns:
+//   - T: The largest element. If several elements are equal, the bottom
+//     most one.
+//   - error: An error of type *common.ErrEmpty if the stack is empty.
+func (s *MaxStack[T]) Max() (T, error) {
+	return s.best()
+}
+
+// NewMaxStack creates a new MaxStack that decorates the given stack.
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//   - compare: The comparison function. (e.g., cmp.Compare)
+//
+// Returns:
+//   - *MaxStack: A pointer to the new MaxStack.
+//   - error: An error of type *common.ErrInvalidParameter if stack or
+//     compare is nil.
+func NewMaxStack[T any](stack Stacker[T], compare func(a, b T) int) (*MaxStack[T], error) {
+	es, err := new_extremum_stack(stack, compare, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxStack[T]{es}, nil
+}
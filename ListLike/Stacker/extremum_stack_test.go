@@ -0,0 +1,112 @@
+package Stacker
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestMinStack(t *testing.T) {
+	s, err := NewMinStack[int](NewLinkedStack(5, 3), cmp.Compare[int])
+	if err != nil {
+		t.Fatalf("NewMinStack failed: %s", err.Error())
+	}
+
+	tests := []struct {
+		push     int
+		expected int
+	}{
+		{4, 3},
+		{1, 1},
+		{2, 1},
+		{1, 1},
+	}
+
+	for _, test := range tests {
+		_ = s.Push(test.push)
+
+		min, err := s.Min()
+		if err != nil {
+			t.Fatalf("Min failed: %s", err.Error())
+		} else if min != test.expected {
+			t.Errorf("Min failed: expected %d, got %d", test.expected, min)
+		}
+	}
+
+	expected := []int{1, 1, 1, 3, 3, 5}
+
+	for _, exp := range expected {
+		min, _ := s.Min()
+		if min != exp {
+			t.Errorf("Min failed: expected %d, got %d", exp, min)
+		}
+
+		_, _ = s.Pop()
+	}
+
+	_, err = s.Min()
+	if err == nil {
+		t.Errorf("Min failed: expected an error on an empty stack")
+	}
+}
+
+func TestMaxStack(t *testing.T) {
+	s, err := NewMaxStack[int](NewLinkedStack[int](), cmp.Compare[int])
+	if err != nil {
+		t.Fatalf("NewMaxStack failed: %s", err.Error())
+	}
+
+	for _, x := range []int{2, 7, 4, 9} {
+		_ = s.Push(x)
+	}
+
+	popped := s.PopWhile(func(x int) bool { return x > 5 })
+	if len(popped) != 1 {
+		t.Errorf("PopWhile failed: expected %d element, got %d", 1, len(popped))
+	}
+
+	max, _ := s.Max()
+	if max != 7 {
+		t.Errorf("Max failed: expected %d, got %d", 7, max)
+	}
+
+	s.Clear()
+
+	_, err = s.Max()
+	if err == nil {
+		t.Errorf("Max failed: expected an error on an empty stack")
+	}
+}
+
+func TestMinStackOverwrite(t *testing.T) {
+	as, err := NewArrayStack[int](3, OverwriteOldest)
+	if err != nil {
+		t.Fatalf("NewArrayStack failed: %s", err.Error())
+	}
+
+	s, err := NewMinStack[int](as, cmp.Compare[int])
+	if err != nil {
+		t.Fatalf("NewMinStack failed: %s", err.Error())
+	}
+
+	for _, x := range []int{1, 5, 4, 6} {
+		_ = s.Push(x)
+	}
+
+	// The 1 at the bottom was discarded to make room for the 6.
+	min, _ := s.Min()
+	if min != 4 {
+		t.Errorf("Min failed: expected %d, got %d", 4, min)
+	}
+}
+
+func TestNewMinStackNil(t *testing.T) {
+	_, err := NewMinStack[int](nil, cmp.Compare[int])
+	if err == nil {
+		t.Errorf("NewMinStack failed: expected an error for a nil stack")
+	}
+
+	_, err = NewMaxStack[int](NewLinkedStack[int](), nil)
+	if err == nil {
+		t.Errorf("NewMaxStack failed: expected an error for a nil comparison")
+	}
+}
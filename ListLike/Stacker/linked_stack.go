@@ -0,0 +1,181 @@
+package Stacker
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// stack_node is a node of a LinkedStack.
+type stack_node[T any] struct {
+	// value is the value of the node.
+	value T
+
+	// next is the node below this one.
+	next *stack_node[T]
+}
+
+// LinkedStack is an unbounded stack implemented with a singly linked list.
+type LinkedStack[T any] struct {
+	// front is the node on top of the stack.
+	front *stack_node[T]
+
+	// size is the number of elements in the stack.
+	size int
+}
+
+// Push implements the Stacker interface.
+//
+// Never returns an error.
+func (s *LinkedStack[T]) Push(value T) error {
+	s.front = &stack_node[T]{
+		value: value,
+		next:  s.front,
+	}
+
+	s.size++
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *LinkedStack[T]) Pop() (T, error) {
+	if s.front == nil {
+		return *new(T), uc.NewErrEmpty("stack")
+	}
+
+	top := s.front
+	s.front = top.next
+	s.size--
+
+	top.next = nil // Help the GC.
+
+	return top.value, nil
+}
+
+// Peek implements the Stacker interface.
+func (s *LinkedStack[T]) Peek() (T, error) {
+	if s.front == nil {
+		return *new(T), uc.NewErrEmpty("stack")
+	}
+
+	return s.front.value, nil
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *LinkedStack[T]) IsEmpty() bool {
+	return s.front == nil
+}
+
+// Size implements the Stacker interface.
+func (s *LinkedStack[T]) Size() int {
+	return s.size
+}
+
+// Capacity implements the Stacker interface.
+//
+// Always returns -1.
+func (s *LinkedStack[T]) Capacity() int {
+	return -1
+}
+
+// IsFull implements the Stacker interface.
+//
+// Always returns false.
+func (s *LinkedStack[T]) IsFull() bool {
+	return false
+}
+
+// Clear implements the Stacker interface.
+func (s *LinkedStack[T]) Clear() {
+	s.front = nil
+	s.size = 0
+}
+
+// Slice implements the Stacker interface.
+func (s *LinkedStack[T]) Slice() []T {
+	slice := make([]T, s.size)
+
+	i := s.size - 1
+	for node := s.front; node != nil; node = node.next {
+		slice[i] = node.value
+		i--
+	}
+
+	return slice
+}
+
+// PopWhile implements the Stacker interface.
+func (s *LinkedStack[T]) PopWhile(pred func(T) bool) []T {
+	return pop_while[T](s, pred)
+}
+
+// PopN implements the Stacker interface.
+func (s *LinkedStack[T]) PopN(n int) ([]T, bool) {
+	return pop_n[T](s, n)
+}
+
+// DrainIterator implements the Stacker interface.
+func (s *LinkedStack[T]) DrainIterator() uc.Iterater[T] {
+	return Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+//
+// The iterator works on a snapshot of the stack; changes made to the stack
+// afterwards are not seen.
+func (s *LinkedStack[T]) Iterator() uc.Iterater[T] {
+	values := make([]T, 0, s.size)
+
+	for node := s.front; node != nil; node = node.next {
+		values = append(values, node.value)
+	}
+
+	return uc.NewSimpleIterator(values)
+}
+
+// NewLinkedStack creates a new LinkedStack.
+//
+// Parameters:
+//   - values: The initial values, pushed in order; so the last value ends up
+//     on top of the stack.
+//
+// Returns:
+//   - *LinkedStack: A pointer to the new LinkedStack.
+func NewLinkedStack[T any](values ...T) *LinkedStack[T] {
+	s := new(LinkedStack[T])
+
+	for _, value := range values {
+		_ = s.Push(value)
+	}
+
+	return s
+}
+
+// NewStackFromIterator creates a new LinkedStack with the elements of an
+// iterator.
+//
+// Parameters:
+//   - iter: The iterator. Its elements are pushed in order; so the last one
+//     ends up on top of the stack.
+//
+// Returns:
+//   - *LinkedStack: A pointer to the new LinkedStack.
+//   - error: An error of type *common.ErrInvalidParameter if iter is nil,
+//     or the error the iterator failed with, if other than exhaustion.
+func NewStackFromIterator[T any](iter uc.Iterater[T]) (*LinkedStack[T], error) {
+	if iter == nil {
+		return nil, uc.NewErrNilParameter("iter")
+	}
+
+	s := new(LinkedStack[T])
+
+	for {
+		value, err := iter.Consume()
+		if uc.IsDone(err) {
+			return s, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		_ = s.Push(value)
+	}
+}
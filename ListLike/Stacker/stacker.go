@@ -0,0 +1,193 @@
+package Stacker
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Stacker is an interface for a last-in, first-out collection.
+type Stacker[T any] interface {
+	// Push adds an element on top of the stack.
+	//
+	// Parameters:
+	//   - value: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added. (e.g., the
+	//     stack is full)
+	Push(value T) error
+
+	// Pop removes the element on top of the stack.
+	//
+	// Returns:
+	//   - T: The removed element.
+	//   - error: An error of type *common.ErrEmpty if the stack is empty.
+	Pop() (T, error)
+
+	// Peek returns the element on top of the stack without removing it.
+	//
+	// Returns:
+	//   - T: The element on top of the stack.
+	//   - error: An error of type *common.ErrEmpty if the stack is empty.
+	Peek() (T, error)
+
+	// IsEmpty checks if the stack is empty.
+	//
+	// Returns:
+	//   - bool: True if the stack is empty, false otherwise.
+	IsEmpty() bool
+
+	// Size returns the number of elements in the stack.
+	//
+	// Returns:
+	//   - int: The number of elements in the stack.
+	Size() int
+
+	// Capacity returns the maximum number of elements the stack can hold.
+	//
+	// Returns:
+	//   - int: The capacity of the stack, or -1 if it is unbounded.
+	Capacity() int
+
+	// IsFull checks if the stack cannot accept more elements.
+	//
+	// Returns:
+	//   - bool: True if the stack is full, false otherwise. Unbounded stacks
+	//     are never full.
+	IsFull() bool
+
+	// Clear removes all the elements from the stack.
+	Clear()
+
+	// Slice returns the elements of the stack, from bottom to top.
+	//
+	// Returns:
+	//   - []T: A copy of the elements of the stack.
+	Slice() []T
+
+	// PopWhile pops elements as long as the element on top of the stack
+	// satisfies a predicate.
+	//
+	// Parameters:
+	//   - pred: The predicate.
+	//
+	// Returns:
+	//   - []T: The popped elements, in the order they were popped. Nil if
+	//     pred is nil or no element was popped.
+	PopWhile(pred func(T) bool) []T
+
+	// PopN pops n elements, or none at all.
+	//
+	// Parameters:
+	//   - n: The number of elements to pop.
+	//
+	// Returns:
+	//   - []T: The popped elements, in the order they were popped.
+	//   - bool: False if the stack has fewer than n elements, in which case
+	//     nothing is popped.
+	PopN(n int) ([]T, bool)
+
+	// DrainIterator returns an iterator that pops the elements of the stack
+	// as it goes.
+	//
+	// Returns:
+	//   - common.Iterater[T]: The iterator. Restart has no effect on it.
+	DrainIterator() uc.Iterater[T]
+
+	// Iterator returns an iterator over the elements of the stack, from top
+	// to bottom.
+	uc.Iterable[T]
+}
+
+// pop_while implements Stacker.PopWhile in terms of Peek and Pop.
+//
+// Parameters:
+//   - s: The stack.
+//   - pred: The predicate.
+//
+// Returns:
+//   - []T: The popped elements.
+func pop_while[T any](s Stacker[T], pred func(T) bool) []T {
+	if pred == nil {
+		return nil
+	}
+
+	var popped []T
+
+	for {
+		top, err := s.Peek()
+		if err != nil || !pred(top) {
+			return popped
+		}
+
+		_, _ = s.Pop()
+
+		popped = append(popped, top)
+	}
+}
+
+// pop_n implements Stacker.PopN in terms of Size and Pop.
+//
+// Parameters:
+//   - s: The stack.
+//   - n: The number of elements to pop.
+//
+// Returns:
+//   - []T: The popped elements.
+//   - bool: False if the stack has fewer than n elements.
+func pop_n[T any](s Stacker[T], n int) ([]T, bool) {
+	if n < 0 || s.Size() < n {
+		return nil, false
+	}
+
+	popped := make([]T, 0, n)
+
+	for i := 0; i < n; i++ {
+		top, _ := s.Pop()
+		popped = append(popped, top)
+	}
+
+	return popped, true
+}
+
+// drain_iter is the iterator returned by Drain.
+type drain_iter[T any] struct {
+	// stack is the stack being drained.
+	stack Stacker[T]
+}
+
+// Consume implements the common.Iterater interface.
+func (it *drain_iter[T]) Consume() (T, error) {
+	top, err := it.stack.Pop()
+	if err != nil {
+		return *new(T), uc.NewErrExhaustedIter()
+	}
+
+	return top, nil
+}
+
+// Restart implements the common.Iterater interface.
+//
+// Does nothing: popped elements are gone.
+func (it *drain_iter[T]) Restart() {}
+
+// Drain returns an iterator that pops the elements of a stack as it goes;
+// so each element is released as soon as it is consumed. Implementations
+// of Stacker.DrainIterator can use it.
+//
+// Parameters:
+//   - s: The stack.
+//
+// Returns:
+//   - common.Iterater[T]: The iterator. Nil if s is nil.
+//
+// Behaviors:
+//   - Elements pushed while draining are consumed as well.
+func Drain[T any](s Stacker[T]) uc.Iterater[T] {
+	if s == nil {
+		return nil
+	}
+
+	return &drain_iter[T]{
+		stack: s,
+	}
+}
@@ -0,0 +1,158 @@
+package Stacker
+
+import (
+	"slices"
+	"testing"
+
+	itr "github.com/PlayerR9/MyGoLib/Utility/Iterators"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+func TestLinkedStack(t *testing.T) {
+	s := NewLinkedStack(1, 2, 3)
+
+	top, err := s.Pop()
+	if err != nil {
+		t.Fatalf("Pop failed: %s", err.Error())
+	} else if top != 3 {
+		t.Errorf("Pop failed: expected %d, got %d", 3, top)
+	}
+
+	_ = s.Push(4)
+
+	expected := []int{1, 2, 4}
+	if got := s.Slice(); !slices.Equal(got, expected) {
+		t.Errorf("Slice failed: expected %v, got %v", expected, got)
+	}
+
+	s.Clear()
+
+	_, err = s.Pop()
+	if err == nil {
+		t.Errorf("Pop failed: expected an error on an empty stack")
+	}
+}
+
+func TestArrayStack(t *testing.T) {
+	tests := []struct {
+		policy   OverflowPolicy
+		expected []int
+		failed   bool
+	}{
+		{RejectOnFull, []int{1, 2, 3}, true},
+		{OverwriteOldest, []int{3, 4, 5}, false},
+		{GrowOnFull, []int{1, 2, 3, 4, 5}, false},
+	}
+
+	for _, test := range tests {
+		s, err := NewArrayStack[int](3, test.policy)
+		if err != nil {
+			t.Fatalf("NewArrayStack failed: %s", err.Error())
+		}
+
+		failed := false
+
+		for i := 1; i <= 5; i++ {
+			err := s.Push(i)
+			if err != nil {
+				failed = true
+			}
+		}
+
+		if failed != test.failed {
+			t.Errorf("Push failed (%s): expected failure %t, got %t", test.policy, test.failed, failed)
+		}
+
+		if got := s.Slice(); !slices.Equal(got, test.expected) {
+			t.Errorf("Slice failed (%s): expected %v, got %v", test.policy, test.expected, got)
+		}
+
+		top, err := s.Pop()
+		if err != nil {
+			t.Fatalf("Pop failed (%s): %s", test.policy, err.Error())
+		} else if top != test.expected[len(test.expected)-1] {
+			t.Errorf("Pop failed (%s): expected %d, got %d", test.policy, test.expected[len(test.expected)-1], top)
+		}
+	}
+}
+
+func TestOverflowPolicyString(t *testing.T) {
+	tests := []struct {
+		policy   OverflowPolicy
+		expected string
+	}{
+		{RejectOnFull, "reject on full"},
+		{OverwriteOldest, "overwrite oldest"},
+		{GrowOnFull, "grow on full"},
+		{OverflowPolicy(7), "OverflowPolicy(7)"},
+		{OverflowPolicy(-1), "OverflowPolicy(-1)"},
+	}
+
+	for _, test := range tests {
+		if got := test.policy.String(); got != test.expected {
+			t.Errorf("String failed: expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestPopOperations(t *testing.T) {
+	s := NewLinkedStack(0, 1, 2, 3, 4)
+
+	popped := s.PopWhile(func(x int) bool { return x > 2 })
+	if !slices.Equal(popped, []int{4, 3}) {
+		t.Errorf("PopWhile failed: expected %v, got %v", []int{4, 3}, popped)
+	}
+
+	_, ok := s.PopN(4)
+	if ok || s.Size() != 3 {
+		t.Errorf("PopN failed: expected no pop, got size %d", s.Size())
+	}
+
+	popped, ok = s.PopN(2)
+	if !ok || !slices.Equal(popped, []int{2, 1}) {
+		t.Errorf("PopN failed: expected %v, got %v", []int{2, 1}, popped)
+	}
+
+	iter := s.DrainIterator()
+
+	top, err := iter.Consume()
+	if err != nil || top != 0 || !s.IsEmpty() {
+		t.Errorf("DrainIterator failed: expected 0 and an empty stack, got %d", top)
+	}
+}
+
+func TestNewStackFromIterator(t *testing.T) {
+	values := uc.NewSimpleIterator([]int{1, 2, 3, 4})
+	even := func(x int) bool { return x%2 == 0 }
+
+	s, err := NewStackFromIterator(itr.ChainIter(itr.FilterIter[int](values, even), itr.NewSliceIterator([]int{5})))
+	if err != nil {
+		t.Fatalf("NewStackFromIterator failed: %s", err.Error())
+	}
+
+	expected := []int{2, 4, 5}
+	if got := s.Slice(); !slices.Equal(got, expected) {
+		t.Errorf("NewStackFromIterator failed: expected %v, got %v", expected, got)
+	}
+
+	values.Restart()
+
+	// An iterator that fails, other than by being exhausted, is reported.
+	failing := itr.NewSafeIterater(itr.MapIter[int](values, func(x int) int {
+		if x == 3 {
+			panic("boom")
+		}
+
+		return x
+	}))
+
+	_, err = NewStackFromIterator(failing)
+	if !uc.Is[*uc.ErrPanic](err) {
+		t.Errorf("NewStackFromIterator failed: expected *common.ErrPanic, got %v", err)
+	}
+
+	_, err = NewStackFromIterator[int](nil)
+	if err == nil {
+		t.Errorf("NewStackFromIterator failed: expected an error for a nil iterator")
+	}
+}
@@ -0,0 +1,273 @@
+package Stacker
+
+import (
+	"slices"
+
+	dbg "github.com/PlayerR9/MyGoLib/Utility/Debugging"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// push_values returns a function that pushes values onto a stack, in order.
+//
+// Parameters:
+//   - values: The values to push.
+//
+// Returns:
+//   - func(Stacker[T]) error: The function.
+func push_values[T any](values []T) func(Stacker[T]) error {
+	return func(s Stacker[T]) error {
+		for _, value := range values {
+			err := s.Push(value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// pop_values returns a function that pops n values from a stack.
+//
+// Parameters:
+//   - n: The number of values to pop.
+//
+// Returns:
+//   - func(Stacker[T]) error: The function.
+func pop_values[T any](n int) func(Stacker[T]) error {
+	return func(s Stacker[T]) error {
+		_, ok := s.PopN(n)
+		if !ok {
+			return uc.NewErrEmpty("stack")
+		}
+
+		return nil
+	}
+}
+
+// restore_values returns a function that replaces the contents of a stack.
+//
+// Parameters:
+//   - values: The new contents, from bottom to top.
+//
+// Returns:
+//   - func(Stacker[T]) error: The function.
+func restore_values[T any](values []T) func(Stacker[T]) error {
+	push := push_values(values)
+
+	return func(s Stacker[T]) error {
+		s.Clear()
+
+		return push(s)
+	}
+}
+
+// UndoableStack is a decorator that records every change made to a Stacker
+// in a Debugging.History, so that the changes can be undone and redone.
+//
+// Only the operations that succeed are recorded. Undoing an operation costs
+// as much as the operation itself, except for Clear and for a Push that
+// made the decorated stack discard its bottom element (see OverwriteOldest),
+// whose undo restores a snapshot of the stack in O(n).
+type UndoableStack[T any] struct {
+	// history is the history of the decorated stack.
+	history *dbg.History[Stacker[T]]
+}
+
+// stack returns the decorated stack.
+//
+// Returns:
+//   - Stacker[T]: The decorated stack.
+func (s *UndoableStack[T]) stack() Stacker[T] {
+	return s.history.GetData()
+}
+
+// Push implements the Stacker interface.
+func (s *UndoableStack[T]) Push(value T) error {
+	stack := s.stack()
+
+	var snapshot []T
+
+	if stack.IsFull() {
+		snapshot = stack.Slice()
+	}
+
+	err := stack.Push(value)
+	if err != nil {
+		return err
+	}
+
+	undo := pop_values[T](1)
+	if snapshot != nil {
+		undo = restore_values(snapshot)
+	}
+
+	s.history.RecordCommand(dbg.NewCommand(push_values([]T{value}), undo))
+
+	return nil
+}
+
+// Pop implements the Stacker interface.
+func (s *UndoableStack[T]) Pop() (T, error) {
+	top, err := s.stack().Pop()
+	if err != nil {
+		return *new(T), err
+	}
+
+	s.history.RecordCommand(dbg.NewCommand(pop_values[T](1), push_values([]T{top})))
+
+	return top, nil
+}
+
+// Peek implements the Stacker interface.
+func (s *UndoableStack[T]) Peek() (T, error) {
+	return s.stack().Peek()
+}
+
+// IsEmpty implements the Stacker interface.
+func (s *UndoableStack[T]) IsEmpty() bool {
+	return s.stack().IsEmpty()
+}
+
+// Size implements the Stacker interface.
+func (s *UndoableStack[T]) Size() int {
+	return s.stack().Size()
+}
+
+// Capacity implements the Stacker interface.
+func (s *UndoableStack[T]) Capacity() int {
+	return s.stack().Capacity()
+}
+
+// IsFull implements the Stacker interface.
+func (s *UndoableStack[T]) IsFull() bool {
+	return s.stack().IsFull()
+}
+
+// Clear implements the Stacker interface.
+//
+// Clearing an empty stack is not recorded.
+func (s *UndoableStack[T]) Clear() {
+	stack := s.stack()
+	if stack.IsEmpty() {
+		return
+	}
+
+	snapshot := stack.Slice()
+	stack.Clear()
+
+	clear_stack := func(s Stacker[T]) error {
+		s.Clear()
+		return nil
+	}
+
+	s.history.RecordCommand(dbg.NewCommand(clear_stack, restore_values(snapshot)))
+}
+
+// Slice implements the Stacker interface.
+func (s *UndoableStack[T]) Slice() []T {
+	return s.stack().Slice()
+}
+
+// PopWhile implements the Stacker interface.
+//
+// The popped elements are recorded as a single operation.
+func (s *UndoableStack[T]) PopWhile(pred func(T) bool) []T {
+	popped := s.stack().PopWhile(pred)
+	if len(popped) == 0 {
+		return popped
+	}
+
+	s.record_pops(popped)
+
+	return popped
+}
+
+// PopN implements the Stacker interface.
+//
+// The popped elements are recorded as a single operation.
+func (s *UndoableStack[T]) PopN(n int) ([]T, bool) {
+	popped, ok := s.stack().PopN(n)
+	if !ok || len(popped) == 0 {
+		return popped, ok
+	}
+
+	s.record_pops(popped)
+
+	return popped, true
+}
+
+// record_pops records that elements were popped.
+//
+// Parameters:
+//   - popped: The popped elements, in the order they were popped.
+func (s *UndoableStack[T]) record_pops(popped []T) {
+	values := slices.Clone(popped)
+	slices.Reverse(values)
+
+	s.history.RecordCommand(dbg.NewCommand(pop_values[T](len(values)), push_values(values)))
+}
+
+// DrainIterator implements the Stacker interface.
+//
+// Each element consumed is recorded as a separate Pop.
+func (s *UndoableStack[T]) DrainIterator() uc.Iterater[T] {
+	return Drain[T](s)
+}
+
+// Iterator implements the Stacker interface.
+func (s *UndoableStack[T]) Iterator() uc.Iterater[T] {
+	return s.stack().Iterator()
+}
+
+// Undo undoes the last recorded operation.
+//
+// Returns:
+//   - error: An error if the undo fails.
+//
+// Behaviors:
+//   - If there are no operations to undo, no action is taken.
+func (s *UndoableStack[T]) Undo() error {
+	return s.history.UndoLastCommand()
+}
+
+// Redo executes again the last undone operation.
+//
+// Returns:
+//   - error: An error if the execution fails.
+//
+// Behaviors:
+//   - If there are no operations to redo, no action is taken.
+func (s *UndoableStack[T]) Redo() error {
+	return s.history.Redo()
+}
+
+// History returns the history of the stack; for example, to switch
+// between the branches left by undone operations.
+//
+// Returns:
+//   - *Debugging.History[Stacker[T]]: The history. Never nil.
+func (s *UndoableStack[T]) History() *dbg.History[Stacker[T]] {
+	return s.history
+}
+
+// NewUndoableStack creates a new UndoableStack that decorates the given
+// stack.
+//
+// Parameters:
+//   - stack: The stack to decorate. It must not be used directly afterwards.
+//
+// Returns:
+//   - *UndoableStack: A pointer to the new UndoableStack.
+//   - error: An error of type *common.ErrInvalidParameter if stack is nil.
+func NewUndoableStack[T any](stack Stacker[T]) (*UndoableStack[T], error) {
+	if stack == nil {
+		return nil, uc.NewErrNilParameter("stack")
+	}
+
+	s := &UndoableStack[T]{
+		history: dbg.NewHistory(stack),
+	}
+
+	return s, nil
+}
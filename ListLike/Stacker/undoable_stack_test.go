@@ -0,0 +1,85 @@
+package Stacker
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUndoableStack(t *testing.T) {
+	s, err := NewUndoableStack[int](NewLinkedStack(1, 2))
+	if err != nil {
+		t.Fatalf("NewUndoableStack failed: %s", err.Error())
+	}
+
+	_ = s.Push(3)
+	_, _ = s.Pop()
+	_, _ = s.PopN(1)
+	s.Clear()
+
+	// A failed Pop is not recorded.
+	_, err = s.Pop()
+	if err == nil {
+		t.Errorf("Pop failed: expected an error on an empty stack")
+	}
+
+	expected := [][]int{
+		{1},
+		{1, 2},
+		{1, 2, 3},
+		{1, 2},
+		{1, 2}, // Nothing left to undo.
+	}
+
+	for _, exp := range expected {
+		err := s.Undo()
+		if err != nil {
+			t.Fatalf("Undo failed: %s", err.Error())
+		}
+
+		if got := s.Slice(); !slices.Equal(got, exp) {
+			t.Errorf("Undo failed: expected %v, got %v", exp, got)
+		}
+	}
+
+	_ = s.Redo()
+	_ = s.Redo()
+
+	if got := s.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Redo failed: expected %v, got %v", []int{1, 2}, got)
+	}
+
+	_, _ = s.PopN(2)
+	_ = s.Undo()
+
+	if got := s.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Undo failed: expected %v, got %v", []int{1, 2}, got)
+	}
+
+	_, err = NewUndoableStack[int](nil)
+	if err == nil {
+		t.Errorf("NewUndoableStack failed: expected an error for a nil stack")
+	}
+}
+
+func TestUndoableStackOverwrite(t *testing.T) {
+	as, err := NewArrayStack[int](2, OverwriteOldest)
+	if err != nil {
+		t.Fatalf("NewArrayStack failed: %s", err.Error())
+	}
+
+	s, err := NewUndoableStack[int](as)
+	if err != nil {
+		t.Fatalf("NewUndoableStack failed: %s", err.Error())
+	}
+
+	_ = s.Push(1)
+	_ = s.Push(2)
+	_ = s.Push(3)
+
+	// Undoing the last Push brings back the discarded bottom element.
+	_ = s.Undo()
+
+	if got := s.Slice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Undo failed: expected %v, got %v", []int{1, 2}, got)
+	}
+}
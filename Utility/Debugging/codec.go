@@ -0,0 +1,150 @@
+package Debugging
+
+import (
+	"fmt"
+	"sync"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Kinder is implemented by the commands that can be persisted.
+type Kinder interface {
+	// Kind returns the kind of the command; that is, the name of the codec
+	// used to persist it. (see CodecRegistry.Register)
+	//
+	// Returns:
+	//   - string: The kind of the command. Empty if it cannot be persisted.
+	Kind() string
+}
+
+// CommandCodec encodes and decodes the commands of one kind.
+type CommandCodec[T any] struct {
+	// Encode encodes a command into a payload.
+	Encode func(cmd Commander[T]) ([]byte, error)
+
+	// Decode rebuilds a command from a payload produced by Encode.
+	Decode func(payload []byte) (Commander[T], error)
+}
+
+// CodecRegistry maps kinds of commands to the codecs used to persist them.
+//
+// Commands are matched to their kind by name, through the Kinder interface;
+// so commands of the same type, such as *Command[T], can have different
+// kinds. A CodecRegistry is safe for concurrent use.
+type CodecRegistry[T any] struct {
+	// codecs are the codecs, keyed by kind.
+	codecs map[string]CommandCodec[T]
+
+	// mu is the mutex that protects codecs.
+	mu sync.RWMutex
+}
+
+// NewCodecRegistry creates a new, empty, CodecRegistry.
+//
+// Returns:
+//   - *CodecRegistry: A pointer to the new CodecRegistry.
+func NewCodecRegistry[T any]() *CodecRegistry[T] {
+	r := &CodecRegistry[T]{
+		codecs: make(map[string]CommandCodec[T]),
+	}
+
+	return r
+}
+
+// Register registers the codec of a kind of command.
+//
+// Parameters:
+//   - kind: The name of the kind, as returned by Kinder.Kind and written on
+//     disk. It must be stable across versions of the program.
+//   - codec: The codec.
+//
+// Returns:
+//   - error: An error of type *common.ErrInvalidParameter if a parameter is
+//     empty or nil, or if the kind is already registered.
+func (r *CodecRegistry[T]) Register(kind string, codec CommandCodec[T]) error {
+	if kind == "" {
+		return uc.NewErrInvalidParameter("kind", uc.NewErrEmpty("kind"))
+	} else if codec.Encode == nil {
+		return uc.NewErrNilParameter("codec.Encode")
+	} else if codec.Decode == nil {
+		return uc.NewErrNilParameter("codec.Decode")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.codecs[kind]
+	if ok {
+		return uc.NewErrInvalidParameter("kind", fmt.Errorf("kind %q is already registered", kind))
+	}
+
+	r.codecs[kind] = codec
+
+	return nil
+}
+
+// encode encodes a command with the codec of its kind.
+//
+// Parameters:
+//   - cmd: The command.
+//
+// Returns:
+//   - string: The kind of the command.
+//   - []byte: The payload.
+//   - error: An error if the command has no kind, if its kind is not
+//     registered, or if the encoding fails.
+func (r *CodecRegistry[T]) encode(cmd Commander[T]) (string, []byte, error) {
+	var kind string
+
+	k, ok := cmd.(Kinder)
+	if ok {
+		kind = k.Kind()
+	}
+
+	if kind == "" {
+		return "", nil, fmt.Errorf("command of type %T has no kind", cmd)
+	}
+
+	r.mu.RLock()
+	codec, ok := r.codecs[kind]
+	r.mu.RUnlock()
+
+	if !ok {
+		return "", nil, fmt.Errorf("no codec registered for kind %q", kind)
+	}
+
+	payload, err := codec.Encode(cmd)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not encode %q command: %w", kind, err)
+	}
+
+	return kind, payload, nil
+}
+
+// decode decodes a payload with the codec of a kind.
+//
+// Parameters:
+//   - kind: The kind of the command.
+//   - payload: The payload.
+//
+// Returns:
+//   - Commander[T]: The command.
+//   - error: An error if the kind is not registered or if the decoding fails.
+func (r *CodecRegistry[T]) decode(kind string, payload []byte) (Commander[T], error) {
+	r.mu.RLock()
+	codec, ok := r.codecs[kind]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no codec registered for kind %q", kind)
+	}
+
+	cmd, err := codec.Decode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %q command: %w", kind, err)
+	} else if cmd == nil {
+		return nil, fmt.Errorf("could not decode %q command: codec returned nil", kind)
+	}
+
+	return cmd, nil
+}
@@ -1,5 +1,11 @@
 package Debugging
 
+import (
+	"slices"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
 // Commander is an interface that represents a command that can be
 // executed and undone.
 type Commander[T any] interface {
@@ -29,6 +35,14 @@ type Command[T any] struct {
 
 	// undo represents the function to undo the command.
 	undo func(data T) error
+
+	// kind is the kind of the command. Empty if it cannot be persisted.
+	kind string
+}
+
+// Kind implements the Kinder interface.
+func (c *Command[T]) Kind() string {
+	return c.kind
 }
 
 // Execute implements the Commander interface.
@@ -75,13 +89,120 @@ func NewCommand[T any](execute, undo func(data T) error) Commander[T] {
 	return cmd
 }
 
+// NewKindedCommand is like NewCommand, except that the command has a kind;
+// so it can be persisted with the codec of that kind. (see CodecRegistry)
+//
+// Parameters:
+//   - kind: The kind of the command.
+//   - execute: The function to execute the command.
+//   - undo: The function to undo the command.
+//
+// Returns:
+//   - Commander: The new command.
+//
+// Behaviors:
+//   - If either the execute or undo functions are nil, nil is returned.
+func NewKindedCommand[T any](kind string, execute, undo func(data T) error) Commander[T] {
+	if execute == nil || undo == nil {
+		return nil
+	}
+
+	cmd := &Command[T]{
+		execute: execute,
+		undo:    undo,
+		kind:    kind,
+	}
+
+	return cmd
+}
+
+// history_node is a node of the tree of histories. Each node holds the
+// command that leads to it from its parent.
+type history_node[T any] struct {
+	// id is the identifier of the node.
+	id int
+
+	// cmd is the command that leads to this node. Nil for the root.
+	cmd Commander[T]
+
+	// parent is the parent of the node. Nil for the root.
+	parent *history_node[T]
+
+	// children are the nodes reached by executing a command from this node.
+	children []*history_node[T]
+
+	// active is the index of the child followed by Redo.
+	active int
+
+	// branch is the ID of the branch the node was created on.
+	branch int
+}
+
+// depth returns the number of commands between the root and the node.
+//
+// Returns:
+//   - int: The depth of the node.
+func (n *history_node[T]) depth() int {
+	var d int
+
+	for n.parent != nil {
+		d++
+		n = n.parent
+	}
+
+	return d
+}
+
+// tip returns the leaf reached by following the active children.
+//
+// Returns:
+//   - *history_node[T]: The leaf.
+func (n *history_node[T]) tip() *history_node[T] {
+	for len(n.children) > 0 {
+		n = n.children[n.active]
+	}
+
+	return n
+}
+
+// Branch describes a branch of a History; that is, a sequence of commands
+// from the initial data to a leaf of the tree of histories.
+type Branch struct {
+	// ID is the identifier of the branch. It does not change when commands
+	// are executed at the end of the branch.
+	ID int
+
+	// Length is the number of commands in the branch.
+	Length int
+
+	// IsCurrent is true if the current state lies on this branch and the
+	// branch is the one followed by Redo.
+	IsCurrent bool
+}
+
 // History represents a history of commands that can be executed and undone.
+//
+// Undone commands are not discarded: they can be redone and, if a new
+// command is executed instead, the undone commands are kept as a separate
+// branch that can be switched to or merged later on.
 type History[T any] struct {
 	// data represents the data that the commands are executed on.
 	data T
 
-	// commands represents the commands that have been executed.
-	commands []Commander[T]
+	// root is the root of the tree of histories.
+	root *history_node[T]
+
+	// current is the node of the current state of the data.
+	current *history_node[T]
+
+	// next_id is the identifier of the next node.
+	next_id int
+
+	// next_branch is the identifier of the next branch.
+	next_branch int
+
+	// codecs are the codecs used by Save and Load.
+	codecs *CodecRegistry[T]
 }
 
 // NewHistory creates a new history with the given data.
@@ -93,13 +214,54 @@ type History[T any] struct {
 //   - *History: The new history.
 func NewHistory[T any](data T) *History[T] {
 	h := &History[T]{
-		data:     data,
-		commands: make([]Commander[T], 0),
+		data: data,
 	}
 
+	h.reset()
+
 	return h
 }
 
+// reset discards the whole tree of histories.
+func (h *History[T]) reset() {
+	h.root = &history_node[T]{
+		id: 0,
+	}
+
+	h.current = h.root
+	h.next_id = 1
+	h.next_branch = 1
+}
+
+// push_node adds a node for a command as the active child of the current
+// node and makes it the current node.
+//
+// Parameters:
+//   - cmd: The command that leads to the new node.
+//
+// Behaviors:
+//   - The node continues the branch of its parent if the parent was a leaf;
+//     otherwise, it starts a new branch.
+func (h *History[T]) push_node(cmd Commander[T]) {
+	node := &history_node[T]{
+		id:     h.next_id,
+		cmd:    cmd,
+		parent: h.current,
+		branch: h.current.branch,
+	}
+
+	h.next_id++
+
+	if len(h.current.children) > 0 {
+		node.branch = h.next_branch
+		h.next_branch++
+	}
+
+	h.current.children = append(h.current.children, node)
+	h.current.active = len(h.current.children) - 1
+	h.current = node
+}
+
 // ExecuteCommand executes a command on the history.
 //
 // Parameters:
@@ -110,13 +272,15 @@ func NewHistory[T any](data T) *History[T] {
 //
 // Behaviors:
 //   - If the command is nil, no action is taken.
+//   - If some commands were undone, they are kept as a separate branch.
 func (h *History[T]) ExecuteCommand(cmd Commander[T]) error {
 	if cmd == nil {
 		return nil
 	}
 
 	err := cmd.Execute(h.data)
-	h.commands = append(h.commands, cmd)
+
+	h.push_node(cmd)
 
 	if err != nil {
 		return err
@@ -125,6 +289,24 @@ func (h *History[T]) ExecuteCommand(cmd Commander[T]) error {
 	return nil
 }
 
+// RecordCommand records a command that was already executed on the data,
+// as if it had been executed with ExecuteCommand. This lets callers run an
+// operation first and only record it once it succeeded.
+//
+// Parameters:
+//   - cmd: The executed command.
+//
+// Behaviors:
+//   - If the command is nil, no action is taken.
+//   - If some commands were undone, they are kept as a separate branch.
+func (h *History[T]) RecordCommand(cmd Commander[T]) {
+	if cmd == nil {
+		return
+	}
+
+	h.push_node(cmd)
+}
+
 // UndoLastCommand undoes the last command executed on the history.
 //
 // Returns:
@@ -132,14 +314,38 @@ func (h *History[T]) ExecuteCommand(cmd Commander[T]) error {
 //
 // Behaviors:
 //   - If there are no commands to undo, no action is taken.
+//   - The undone command can be redone with Redo.
 func (h *History[T]) UndoLastCommand() error {
-	if len(h.commands) == 0 {
+	if h.current == h.root {
+		return nil
+	}
+
+	lc := h.current
+	err := lc.cmd.Undo(h.data)
+	h.current = lc.parent
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Redo executes again the last undone command of the current branch.
+//
+// Returns:
+//   - error: An error if the execution fails.
+//
+// Behaviors:
+//   - If there are no commands to redo, no action is taken.
+func (h *History[T]) Redo() error {
+	if len(h.current.children) == 0 {
 		return nil
 	}
 
-	lc := h.commands[len(h.commands)-1]
-	err := lc.Undo(h.data)
-	h.commands = h.commands[:len(h.commands)-1]
+	next := h.current.children[h.current.active]
+	err := next.cmd.Execute(h.data)
+	h.current = next
 
 	if err != nil {
 		return err
@@ -163,23 +369,29 @@ func (h *History[T]) ReadData(f func(data T)) {
 //
 // WARNING: Because the commands are cleared, they cannot be undone after
 // accepting the history. Thus, be sure to use this method only when
-// you are certain that you will never need to undo the commands.
+// you are certain that you will never need to undo the commands. This also
+// discards all the other branches.
 func (h *History[T]) Accept() {
-	h.commands = h.commands[:0]
+	h.reset()
 }
 
 // Reject rejects the history, undoing all commands.
 //
 // Returns:
 //   - error: An error if the undo fails.
+//
+// Behaviors:
+//   - On success, all the branches are discarded.
 func (h *History[T]) Reject() error {
-	for len(h.commands) > 0 {
+	for h.current != h.root {
 		err := h.UndoLastCommand()
 		if err != nil {
 			return err
 		}
 	}
 
+	h.reset()
+
 	return nil
 }
 
@@ -187,3 +399,212 @@ func (h *History[T]) Reject() error {
 func (h *History[T]) GetData() T {
 	return h.data
 }
+
+// leaves returns the leaves of the tree of histories in depth-first order.
+//
+// Returns:
+//   - []*history_node[T]: The leaves.
+func (h *History[T]) leaves() []*history_node[T] {
+	var leaves []*history_node[T]
+
+	stack := []*history_node[T]{h.root}
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if len(top.children) == 0 {
+			leaves = append(leaves, top)
+			continue
+		}
+
+		for i := len(top.children) - 1; i >= 0; i-- {
+			stack = append(stack, top.children[i])
+		}
+	}
+
+	return leaves
+}
+
+// find_leaf returns the leaf of the branch with the given ID.
+//
+// Parameters:
+//   - id: The ID of the branch.
+//
+// Returns:
+//   - *history_node[T]: The leaf.
+//   - error: An error of type *common.ErrInvalidParameter if no branch has
+//     the given ID.
+func (h *History[T]) find_leaf(id int) (*history_node[T], error) {
+	for _, leaf := range h.leaves() {
+		if leaf.branch == id {
+			return leaf, nil
+		}
+	}
+
+	return nil, uc.NewErrInvalidParameter("id", uc.NewErrNotFound())
+}
+
+// Branches returns the branches of the history in depth-first order.
+//
+// Returns:
+//   - []Branch: The branches. Never empty.
+func (h *History[T]) Branches() []Branch {
+	leaves := h.leaves()
+	tip := h.current.tip()
+
+	branches := make([]Branch, 0, len(leaves))
+
+	for _, leaf := range leaves {
+		b := Branch{
+			ID:        leaf.branch,
+			Length:    leaf.depth(),
+			IsCurrent: leaf == tip,
+		}
+
+		branches = append(branches, b)
+	}
+
+	return branches
+}
+
+// CurrentBranch returns the ID of the branch the current state lies on.
+//
+// Returns:
+//   - int: The ID of the current branch.
+func (h *History[T]) CurrentBranch() int {
+	return h.current.tip().branch
+}
+
+// path_from returns the nodes from the child of ancestor down to node.
+//
+// Parameters:
+//   - ancestor: The ancestor.
+//   - node: The node.
+//
+// Returns:
+//   - []*history_node[T]: The nodes, ancestor excluded.
+func path_from[T any](ancestor, node *history_node[T]) []*history_node[T] {
+	var path []*history_node[T]
+
+	for node != ancestor {
+		path = append(path, node)
+		node = node.parent
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// common_ancestor returns the deepest common ancestor of two nodes.
+//
+// Parameters:
+//   - a: The first node.
+//   - b: The second node.
+//
+// Returns:
+//   - *history_node[T]: The common ancestor.
+func common_ancestor[T any](a, b *history_node[T]) *history_node[T] {
+	seen := make(map[*history_node[T]]bool)
+
+	for n := a; n != nil; n = n.parent {
+		seen[n] = true
+	}
+
+	for n := b; n != nil; n = n.parent {
+		if seen[n] {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// SwitchBranch undoes the commands of the current branch back to where it
+// diverges from the branch with the given ID, then executes the commands
+// of that branch up to its end.
+//
+// Parameters:
+//   - id: The ID of the branch to switch to.
+//
+// Returns:
+//   - error: An error of type *common.ErrInvalidParameter if no branch has
+//     the given ID, or the error of the command that failed.
+//
+// Behaviors:
+//   - If a command fails, the history stops at the last successful state.
+func (h *History[T]) SwitchBranch(id int) error {
+	leaf, err := h.find_leaf(id)
+	if err != nil {
+		return err
+	}
+
+	lca := common_ancestor(h.current, leaf)
+
+	for h.current != lca {
+		err := h.UndoLastCommand()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range path_from(lca, leaf) {
+		parent := node.parent
+		parent.active = slices.Index(parent.children, node)
+
+		err := h.Redo()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MergeBranch executes, on top of the current state, the commands of the
+// branch with the given ID that are not shared with the current state. The
+// executed commands are recorded on the current branch, so the merged
+// branch itself is left untouched.
+//
+// Parameters:
+//   - id: The ID of the branch to merge.
+//
+// Returns:
+//   - error: An error of type *common.ErrInvalidParameter if no branch has
+//     the given ID, or the error of the command that failed.
+//
+// Behaviors:
+//   - If a command fails, it is not recorded, and the commands merged before
+//     it are undone and discarded.
+//   - The same command values are executed again, so commands must not
+//     rely on being executed only once.
+func (h *History[T]) MergeBranch(id int) error {
+	leaf, err := h.find_leaf(id)
+	if err != nil {
+		return err
+	}
+
+	lca := common_ancestor(h.current, leaf)
+	start := h.current
+	prevLen, prevActive := len(start.children), start.active
+
+	for _, node := range path_from(lca, leaf) {
+		err := node.cmd.Execute(h.data)
+		if err == nil {
+			h.push_node(node.cmd)
+			continue
+		}
+
+		for h.current != start {
+			_ = h.UndoLastCommand()
+		}
+
+		start.children = start.children[:prevLen]
+		start.active = prevActive
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,540 @@
+package Debugging
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// history_version is the version of the format written by History.Save.
+const history_version int = 1
+
+// errNoCodecs is the error returned by Save and Load when no codecs were set.
+var errNoCodecs error = errors.New("no codecs set; call SetCodecs first")
+
+// history_header is the first record written by History.Save.
+type history_header struct {
+	// Version is the version of the format.
+	Version int `json:"version"`
+
+	// Current is the ID of the node of the current state.
+	Current int `json:"current"`
+
+	// NextID is the identifier of the next node.
+	NextID int `json:"next_id"`
+
+	// NextBranch is the identifier of the next branch.
+	NextBranch int `json:"next_branch"`
+
+	// RootActive is the index of the child of the root followed by Redo.
+	RootActive int `json:"root_active"`
+
+	// Nodes is the number of node records that follow.
+	Nodes int `json:"nodes"`
+}
+
+// history_record is the record of a node written by History.Save.
+type history_record struct {
+	// ID is the identifier of the node.
+	ID int `json:"id"`
+
+	// Parent is the identifier of the parent of the node.
+	Parent int `json:"parent"`
+
+	// Active is the index of the child followed by Redo.
+	Active int `json:"active"`
+
+	// Branch is the ID of the branch the node was created on.
+	Branch int `json:"branch"`
+
+	// Kind is the kind of the command of the node.
+	Kind string `json:"kind"`
+
+	// Payload is the encoded command of the node.
+	Payload []byte `json:"payload"`
+}
+
+// record_meta is a history_record without its payload. It is used to index
+// a saved history without decoding the payloads.
+type record_meta struct {
+	// ID is the identifier of the node.
+	ID int `json:"id"`
+
+	// Parent is the identifier of the parent of the node.
+	Parent int `json:"parent"`
+
+	// Active is the index of the child followed by Redo.
+	Active int `json:"active"`
+
+	// Branch is the ID of the branch the node was created on.
+	Branch int `json:"branch"`
+
+	// Kind is the kind of the command of the node.
+	Kind string `json:"kind"`
+}
+
+// lazy_command is a loaded command whose payload is only decoded the first
+// time the command is executed or undone.
+type lazy_command[T any] struct {
+	// codecs are the codecs used to decode the payload.
+	codecs *CodecRegistry[T]
+
+	// kind is the kind of the command.
+	kind string
+
+	// payload is the encoded command, when kept in memory. Nil once decoded.
+	payload []byte
+
+	// src is the source the record is read from, when not kept in memory.
+	// Nil once decoded.
+	src io.ReaderAt
+
+	// off is the offset of the record in src.
+	off int64
+
+	// size is the size of the record in src.
+	size int
+
+	// cmd is the decoded command. Nil until decoded.
+	cmd Commander[T]
+}
+
+// raw returns the encoded command, reading it from the source if needed.
+//
+// Returns:
+//   - []byte: The encoded command.
+//   - error: An error if the record cannot be read back.
+func (lc *lazy_command[T]) raw() ([]byte, error) {
+	if lc.src == nil {
+		return lc.payload, nil
+	}
+
+	buf := make([]byte, lc.size)
+
+	_, err := lc.src.ReadAt(buf, lc.off)
+	if err != nil && !(errors.Is(err, io.EOF) && len(buf) == lc.size) {
+		return nil, fmt.Errorf("could not read %q command: %w", lc.kind, err)
+	}
+
+	var rec history_record
+
+	err = json.Unmarshal(buf, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %q command: %w", lc.kind, err)
+	}
+
+	return rec.Payload, nil
+}
+
+// load decodes the command, if not done already.
+//
+// Returns:
+//   - Commander[T]: The decoded command.
+//   - error: An error if the decoding fails.
+func (lc *lazy_command[T]) load() (Commander[T], error) {
+	if lc.cmd != nil {
+		return lc.cmd, nil
+	}
+
+	payload, err := lc.raw()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := lc.codecs.decode(lc.kind, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.cmd = cmd
+	lc.payload = nil
+	lc.src = nil
+
+	return cmd, nil
+}
+
+// Execute implements the Commander interface.
+func (lc *lazy_command[T]) Execute(data T) error {
+	cmd, err := lc.load()
+	if err != nil {
+		return err
+	}
+
+	return cmd.Execute(data)
+}
+
+// Undo implements the Commander interface.
+func (lc *lazy_command[T]) Undo(data T) error {
+	cmd, err := lc.load()
+	if err != nil {
+		return err
+	}
+
+	return cmd.Undo(data)
+}
+
+// SetCodecs sets the codecs used by Save and Load.
+//
+// Parameters:
+//   - codecs: The codecs.
+func (h *History[T]) SetCodecs(codecs *CodecRegistry[T]) {
+	h.codecs = codecs
+}
+
+// record returns the record of a node.
+//
+// Parameters:
+//   - node: The node. Must not be the root.
+//
+// Returns:
+//   - history_record: The record.
+//   - error: An error if the command of the node cannot be encoded.
+func (h *History[T]) record(node *history_node[T]) (history_record, error) {
+	rec := history_record{
+		ID:     node.id,
+		Parent: node.parent.id,
+		Active: node.active,
+		Branch: node.branch,
+	}
+
+	cmd := node.cmd
+
+	lc, ok := cmd.(*lazy_command[T])
+	if ok {
+		if lc.cmd == nil {
+			payload, err := lc.raw()
+			if err != nil {
+				return rec, fmt.Errorf("node %d: %w", node.id, err)
+			}
+
+			rec.Kind = lc.kind
+			rec.Payload = payload
+
+			return rec, nil
+		}
+
+		cmd = lc.cmd
+	}
+
+	kind, payload, err := h.codecs.encode(cmd)
+	if err != nil {
+		return rec, fmt.Errorf("node %d: %w", node.id, err)
+	}
+
+	rec.Kind = kind
+	rec.Payload = payload
+
+	return rec, nil
+}
+
+// Save writes the tree of histories, with all its branches, to a writer.
+//
+// Parameters:
+//   - w: The writer.
+//
+// Returns:
+//   - error: An error if no codecs were set, if a command has no kind or no
+//     codec registered for its kind, or if writing fails.
+//
+// Behaviors:
+//   - The data itself is not written, only the commands. (see Load)
+//   - The output is a stream of JSON values, one per line, so that long
+//     histories are never held in memory as a whole.
+//   - Commands loaded but never used are written back without being
+//     decoded.
+//   - A history loaded with LoadLazy must not be saved over its own source.
+func (h *History[T]) Save(w io.Writer) error {
+	if w == nil {
+		return uc.NewErrNilParameter("w")
+	} else if h.codecs == nil {
+		return errNoCodecs
+	}
+
+	var nodes []*history_node[T]
+
+	stack := []*history_node[T]{h.root}
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if top != h.root {
+			nodes = append(nodes, top)
+		}
+
+		for i := len(top.children) - 1; i >= 0; i-- {
+			stack = append(stack, top.children[i])
+		}
+	}
+
+	enc := json.NewEncoder(w)
+
+	header := history_header{
+		Version:    history_version,
+		Current:    h.current.id,
+		NextID:     h.next_id,
+		NextBranch: h.next_branch,
+		RootActive: h.root.active,
+		Nodes:      len(nodes),
+	}
+
+	err := enc.Encode(header)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		rec, err := h.record(node)
+		if err != nil {
+			return err
+		}
+
+		err = enc.Encode(rec)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Load replaces the tree of histories with the one read from a reader, as
+// written by Save.
+//
+// Parameters:
+//   - r: The reader.
+//
+// Returns:
+//   - error: An error if no codecs were set or if the input is malformed.
+//     On error, the history is left unchanged.
+//
+// Behaviors:
+//   - The data is not touched: it must already be in the state it was in
+//     when the history was saved.
+//   - Commands are decoded lazily, the first time they are executed or
+//     undone; so decoding errors are reported by Undo, Redo, and the like.
+//   - The encoded payloads of all the commands are kept in memory until
+//     they are decoded. Use LoadLazy to read them from the source on demand
+//     instead.
+func (h *History[T]) Load(r io.Reader) error {
+	if r == nil {
+		return uc.NewErrNilParameter("r")
+	} else if h.codecs == nil {
+		return errNoCodecs
+	}
+
+	dec := json.NewDecoder(r)
+
+	var header history_header
+
+	err := dec.Decode(&header)
+	if err != nil {
+		return fmt.Errorf("could not read header: %w", err)
+	}
+
+	next := func() (record_meta, *lazy_command[T], error) {
+		var rec history_record
+
+		err := dec.Decode(&rec)
+		if err != nil {
+			return record_meta{}, nil, err
+		}
+
+		meta := record_meta{
+			ID:     rec.ID,
+			Parent: rec.Parent,
+			Active: rec.Active,
+			Branch: rec.Branch,
+			Kind:   rec.Kind,
+		}
+
+		lc := &lazy_command[T]{
+			codecs:  h.codecs,
+			kind:    rec.Kind,
+			payload: rec.Payload,
+		}
+
+		return meta, lc, nil
+	}
+
+	return h.build(header, next)
+}
+
+// LoadLazy is like Load, but only the structure of the tree is kept in
+// memory: the payload of each command is read back from r the first time
+// the command is executed or undone.
+//
+// Parameters:
+//   - r: The source, as written by Save. (e.g., an *os.File)
+//
+// Returns:
+//   - error: An error if no codecs were set or if the input is malformed.
+//     On error, the history is left unchanged.
+//
+// Behaviors:
+//   - r must stay open and unchanged for as long as the history may need
+//     commands that were not decoded yet; read errors are reported by Undo,
+//     Redo, and the like.
+//   - The whole source is still scanned once, to index the records.
+func (h *History[T]) LoadLazy(r io.ReaderAt) error {
+	if r == nil {
+		return uc.NewErrNilParameter("r")
+	} else if h.codecs == nil {
+		return errNoCodecs
+	}
+
+	br := bufio.NewReader(io.NewSectionReader(r, 0, math.MaxInt64))
+
+	var off int64
+
+	read_line := func() ([]byte, int64, error) {
+		line, err := br.ReadBytes('\n')
+		if err != nil && !(errors.Is(err, io.EOF) && len(line) > 0) {
+			return nil, 0, err
+		}
+
+		start := off
+		off += int64(len(line))
+
+		return line, start, nil
+	}
+
+	line, _, err := read_line()
+	if err != nil {
+		return fmt.Errorf("could not read header: %w", err)
+	}
+
+	var header history_header
+
+	err = json.Unmarshal(line, &header)
+	if err != nil {
+		return fmt.Errorf("could not read header: %w", err)
+	}
+
+	next := func() (record_meta, *lazy_command[T], error) {
+		line, start, err := read_line()
+		if err != nil {
+			return record_meta{}, nil, err
+		}
+
+		var meta record_meta
+
+		err = json.Unmarshal(line, &meta)
+		if err != nil {
+			return record_meta{}, nil, err
+		}
+
+		lc := &lazy_command[T]{
+			codecs: h.codecs,
+			kind:   meta.Kind,
+			src:    r,
+			off:    start,
+			size:   len(line),
+		}
+
+		return meta, lc, nil
+	}
+
+	return h.build(header, next)
+}
+
+// build replaces the tree of histories with the one described by a header
+// and its records.
+//
+// Parameters:
+//   - header: The header.
+//   - next: The function that returns the next record and its command.
+//
+// Returns:
+//   - error: An error if the records are malformed. On error, the history
+//     is left unchanged.
+func (h *History[T]) build(header history_header, next func() (record_meta, *lazy_command[T], error)) error {
+	if header.Version != history_version {
+		return fmt.Errorf("unsupported version %d", header.Version)
+	}
+
+	root := &history_node[T]{
+		id: 0,
+	}
+
+	table := map[int]*history_node[T]{
+		0: root,
+	}
+
+	for i := 0; i < header.Nodes; i++ {
+		meta, lc, err := next()
+		if err != nil {
+			return fmt.Errorf("could not read node %d of %d: %w", i+1, header.Nodes, err)
+		}
+
+		_, ok := table[meta.ID]
+		if ok {
+			return fmt.Errorf("duplicate node %d", meta.ID)
+		}
+
+		parent, ok := table[meta.Parent]
+		if !ok {
+			return fmt.Errorf("node %d: unknown parent %d", meta.ID, meta.Parent)
+		}
+
+		node := &history_node[T]{
+			id:     meta.ID,
+			cmd:    lc,
+			parent: parent,
+			active: meta.Active,
+			branch: meta.Branch,
+		}
+
+		parent.children = append(parent.children, node)
+		table[meta.ID] = node
+	}
+
+	root.active = header.RootActive
+
+	branches := make(map[int]int)
+	next_branch := header.NextBranch
+
+	for id, node := range table {
+		if node.active < 0 || (len(node.children) > 0 && node.active >= len(node.children)) {
+			return fmt.Errorf("node %d: invalid active child %d", id, node.active)
+		}
+
+		if node.branch >= next_branch {
+			next_branch = node.branch + 1
+		}
+
+		if len(node.children) > 0 {
+			continue
+		}
+
+		other, ok := branches[node.branch]
+		if ok {
+			return fmt.Errorf("nodes %d and %d: duplicate branch %d", other, id, node.branch)
+		}
+
+		branches[node.branch] = id
+	}
+
+	current, ok := table[header.Current]
+	if !ok {
+		return fmt.Errorf("unknown current node %d", header.Current)
+	}
+
+	h.root = root
+	h.current = current
+	h.next_id = header.NextID
+	h.next_branch = next_branch
+
+	for id := range table {
+		if id >= h.next_id {
+			h.next_id = id + 1
+		}
+	}
+
+	return nil
+}
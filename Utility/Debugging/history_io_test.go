@@ -0,0 +1,214 @@
+package Debugging
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+// add_cmd is a command that adds n to an int.
+type add_cmd struct {
+	n int
+}
+
+func (c *add_cmd) Execute(data *int) error {
+	*data += c.n
+	return nil
+}
+
+func (c *add_cmd) Undo(data *int) error {
+	*data -= c.n
+	return nil
+}
+
+func (c *add_cmd) Kind() string {
+	return "add"
+}
+
+func new_add_codecs(t *testing.T) *CodecRegistry[*int] {
+	codecs := NewCodecRegistry[*int]()
+
+	err := codecs.Register("add", CommandCodec[*int]{
+		Encode: func(cmd Commander[*int]) ([]byte, error) {
+			return []byte(strconv.Itoa(cmd.(*add_cmd).n)), nil
+		},
+		Decode: func(payload []byte) (Commander[*int], error) {
+			n, err := strconv.Atoi(string(payload))
+			if err != nil {
+				return nil, err
+			}
+
+			return &add_cmd{n: n}, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %s", err.Error())
+	}
+
+	return codecs
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	codecs := new_add_codecs(t)
+
+	var data int
+
+	h := NewHistory(&data)
+	h.SetCodecs(codecs)
+
+	for _, n := range []int{1, 2, 3} {
+		err := h.ExecuteCommand(&add_cmd{n: n})
+		if err != nil {
+			t.Fatalf("ExecuteCommand failed: %s", err.Error())
+		}
+	}
+
+	err := h.UndoLastCommand()
+	if err != nil {
+		t.Fatalf("UndoLastCommand failed: %s", err.Error())
+	}
+
+	var buf bytes.Buffer
+
+	err = h.Save(&buf)
+	if err != nil {
+		t.Fatalf("Save failed: %s", err.Error())
+	}
+
+	saved := buf.Bytes()
+
+	loaders := map[string]func(h *History[*int]) error{
+		"Load": func(h *History[*int]) error {
+			return h.Load(bytes.NewReader(saved))
+		},
+		"LoadLazy": func(h *History[*int]) error {
+			return h.LoadLazy(bytes.NewReader(saved))
+		},
+	}
+
+	for name, load := range loaders {
+		restored := data
+
+		h := NewHistory(&restored)
+		h.SetCodecs(codecs)
+
+		err := load(h)
+		if err != nil {
+			t.Fatalf("%s failed: %s", name, err.Error())
+		}
+
+		// Commands that were never used are written back as they were read.
+		var again bytes.Buffer
+
+		err = h.Save(&again)
+		if err != nil {
+			t.Fatalf("%s: Save failed: %s", name, err.Error())
+		}
+
+		if !bytes.Equal(again.Bytes(), saved) {
+			t.Errorf("%s: Save failed: expected %q, got %q", name, saved, again.Bytes())
+		}
+
+		err = h.Redo()
+		if err != nil {
+			t.Fatalf("%s: Redo failed: %s", name, err.Error())
+		}
+
+		if restored != 6 {
+			t.Errorf("%s: Redo failed: expected %d, got %d", name, 6, restored)
+		}
+
+		for i := 0; i < 3; i++ {
+			err = h.UndoLastCommand()
+			if err != nil {
+				t.Fatalf("%s: UndoLastCommand failed: %s", name, err.Error())
+			}
+		}
+
+		if restored != 0 {
+			t.Errorf("%s: UndoLastCommand failed: expected %d, got %d", name, 0, restored)
+		}
+	}
+}
+
+func TestHistoryLoadNil(t *testing.T) {
+	h := NewHistory(new(int))
+	h.SetCodecs(new_add_codecs(t))
+
+	err := h.Load(nil)
+	if err == nil {
+		t.Errorf("Load failed: expected error, got nil")
+	}
+
+	err = h.Save(nil)
+	if err == nil {
+		t.Errorf("Save failed: expected error, got nil")
+	}
+}
+
+func TestCodecRegistryKinds(t *testing.T) {
+	double := func(data *int) error {
+		*data *= 2
+		return nil
+	}
+
+	halve := func(data *int) error {
+		*data /= 2
+		return nil
+	}
+
+	codecs := NewCodecRegistry[*int]()
+
+	for kind, cmd := range map[string]Commander[*int]{
+		"double": NewKindedCommand("double", double, halve),
+		"halve":  NewKindedCommand("halve", halve, double),
+	} {
+		err := codecs.Register(kind, CommandCodec[*int]{
+			Encode: func(Commander[*int]) ([]byte, error) {
+				return nil, nil
+			},
+			Decode: func([]byte) (Commander[*int], error) {
+				return cmd, nil
+			},
+		})
+		if err != nil {
+			t.Fatalf("Register failed: %s", err.Error())
+		}
+	}
+
+	// Both commands are *Command[*int], yet each gets the codec of its kind.
+	for _, kind := range []string{"double", "halve"} {
+		cmd, err := codecs.decode(kind, nil)
+		if err != nil {
+			t.Fatalf("decode failed: %s", err.Error())
+		}
+
+		got, _, err := codecs.encode(cmd)
+		if err != nil {
+			t.Fatalf("encode failed: %s", err.Error())
+		}
+
+		if got != kind {
+			t.Errorf("encode failed: expected kind %q, got %q", kind, got)
+		}
+	}
+
+	_, _, err := codecs.encode(NewCommand(double, halve))
+	if err == nil {
+		t.Errorf("encode failed: expected error for a command without kind, got nil")
+	}
+
+	_, _, err = codecs.encode(NewKindedCommand("triple", double, halve))
+	if err == nil {
+		t.Errorf("encode failed: expected error for an unregistered kind, got nil")
+	}
+
+	err = codecs.Register("triple", CommandCodec[*int]{
+		Encode: func(Commander[*int]) ([]byte, error) {
+			return nil, nil
+		},
+	})
+	if err == nil {
+		t.Errorf("Register failed: expected error for a nil Decode, got nil")
+	}
+}
@@ -0,0 +1,159 @@
+package Debugging
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// flaky_cmd is a command that only succeeds the first time it is executed.
+type flaky_cmd struct {
+	calls int
+	undos int
+}
+
+func (c *flaky_cmd) Execute(data *int) error {
+	c.calls++
+
+	if c.calls > 1 {
+		return errors.New("flaky")
+	}
+
+	return nil
+}
+
+func (c *flaky_cmd) Undo(data *int) error {
+	c.undos++
+	return nil
+}
+
+func must(t *testing.T, name string, f func() error) {
+	t.Helper()
+
+	err := f()
+	if err != nil {
+		t.Fatalf("%s failed: %s", name, err.Error())
+	}
+}
+
+func TestHistoryBranches(t *testing.T) {
+	var data int
+
+	h := NewHistory(&data)
+
+	exec := func(n int) func() error {
+		return func() error { return h.ExecuteCommand(&add_cmd{n: n}) }
+	}
+
+	must(t, "ExecuteCommand", exec(1))
+	must(t, "ExecuteCommand", exec(2))
+	must(t, "UndoLastCommand", h.UndoLastCommand)
+	must(t, "ExecuteCommand", exec(10))
+
+	expected := []Branch{
+		{ID: 0, Length: 2, IsCurrent: false},
+		{ID: 1, Length: 2, IsCurrent: true},
+	}
+
+	branches := h.Branches()
+	if !slices.Equal(branches, expected) {
+		t.Fatalf("Branches failed: expected %v, got %v", expected, branches)
+	}
+
+	// Executing a command at the end of a branch keeps its ID.
+	must(t, "ExecuteCommand", exec(100))
+
+	if h.CurrentBranch() != 1 {
+		t.Errorf("CurrentBranch failed: expected %d, got %d", 1, h.CurrentBranch())
+	}
+
+	must(t, "SwitchBranch", func() error { return h.SwitchBranch(0) })
+
+	if data != 3 {
+		t.Errorf("SwitchBranch failed: expected %d, got %d", 3, data)
+	}
+
+	if h.CurrentBranch() != 0 {
+		t.Errorf("CurrentBranch failed: expected %d, got %d", 0, h.CurrentBranch())
+	}
+
+	must(t, "MergeBranch", func() error { return h.MergeBranch(1) })
+
+	if data != 113 {
+		t.Errorf("MergeBranch failed: expected %d, got %d", 113, data)
+	}
+
+	expected = []Branch{
+		{ID: 0, Length: 4, IsCurrent: true},
+		{ID: 1, Length: 3, IsCurrent: false},
+	}
+
+	branches = h.Branches()
+	if !slices.Equal(branches, expected) {
+		t.Errorf("Branches failed: expected %v, got %v", expected, branches)
+	}
+
+	err := h.SwitchBranch(42)
+	if err == nil {
+		t.Errorf("SwitchBranch failed: expected error, got nil")
+	}
+}
+
+func TestHistoryMergeFailure(t *testing.T) {
+	var data int
+
+	h := NewHistory(&data)
+	flaky := &flaky_cmd{}
+
+	must(t, "ExecuteCommand", func() error { return h.ExecuteCommand(&add_cmd{n: 1}) })
+	must(t, "UndoLastCommand", h.UndoLastCommand)
+	must(t, "ExecuteCommand", func() error { return h.ExecuteCommand(&add_cmd{n: 2}) })
+	must(t, "ExecuteCommand", func() error { return h.ExecuteCommand(flaky) })
+	must(t, "SwitchBranch", func() error { return h.SwitchBranch(0) })
+
+	err := h.MergeBranch(1)
+	if err == nil {
+		t.Fatalf("MergeBranch failed: expected error, got nil")
+	}
+
+	if data != 1 {
+		t.Errorf("MergeBranch failed: expected %d, got %d", 1, data)
+	}
+
+	// The failed command was only undone once, by SwitchBranch.
+	if flaky.undos != 1 {
+		t.Errorf("MergeBranch failed: expected %d undos, got %d", 1, flaky.undos)
+	}
+
+	expected := []Branch{
+		{ID: 0, Length: 1, IsCurrent: true},
+		{ID: 1, Length: 2, IsCurrent: false},
+	}
+
+	branches := h.Branches()
+	if !slices.Equal(branches, expected) {
+		t.Errorf("Branches failed: expected %v, got %v", expected, branches)
+	}
+}
+
+func TestHistoryRecordCommand(t *testing.T) {
+	data := 5
+
+	h := NewHistory(&data)
+
+	// The command was already executed on the data.
+	data += 3
+	h.RecordCommand(&add_cmd{n: 3})
+
+	must(t, "UndoLastCommand", h.UndoLastCommand)
+
+	if data != 5 {
+		t.Errorf("UndoLastCommand failed: expected %d, got %d", 5, data)
+	}
+
+	must(t, "Redo", h.Redo)
+
+	if data != 8 {
+		t.Errorf("Redo failed: expected %d, got %d", 8, data)
+	}
+}
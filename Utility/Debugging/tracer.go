@@ -0,0 +1,452 @@
+package Debugging
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// ring is a fixed-size ring buffer of trace messages.
+type ring struct {
+	// messages are the messages in the buffer.
+	messages []string
+
+	// next is the index where the next message is written.
+	next int
+
+	// isFull is true if the buffer has wrapped around at least once.
+	isFull bool
+}
+
+// add adds a message to the ring, overwriting the oldest one if the
+// ring is full.
+//
+// Parameters:
+//   - msg: The message to add.
+func (r *ring) add(msg string) {
+	r.messages[r.next] = msg
+	r.next++
+
+	if r.next == len(r.messages) {
+		r.next = 0
+		r.isFull = true
+	}
+}
+
+// reset discards the messages in the ring.
+func (r *ring) reset() {
+	clear(r.messages)
+
+	r.next = 0
+	r.isFull = false
+}
+
+// ordered returns the messages in the ring from the oldest to the newest.
+//
+// Returns:
+//   - []string: The messages.
+func (r *ring) ordered() []string {
+	if !r.isFull {
+		msgs := make([]string, r.next)
+		copy(msgs, r.messages[:r.next])
+
+		return msgs
+	}
+
+	msgs := make([]string, 0, len(r.messages))
+	msgs = append(msgs, r.messages[r.next:]...)
+	msgs = append(msgs, r.messages[:r.next]...)
+
+	return msgs
+}
+
+// goroutine_id returns the ID of the calling goroutine.
+//
+// Returns:
+//   - uint64: The ID of the goroutine. 0 if it could not be determined.
+func goroutine_id() uint64 {
+	var buf [64]byte
+
+	n := runtime.Stack(buf[:], false)
+
+	return parse_goroutine_id(buf[:n])
+}
+
+// parse_goroutine_id parses the ID of a goroutine from the header of its
+// stack trace. (e.g., "goroutine 18 [running]:")
+//
+// Parameters:
+//   - stack: The stack trace, or at least its first line.
+//
+// Returns:
+//   - uint64: The ID of the goroutine. 0 if the header does not have the
+//     expected format.
+func parse_goroutine_id(stack []byte) uint64 {
+	rest, ok := bytes.CutPrefix(stack, []byte("goroutine "))
+	if !ok {
+		return 0
+	}
+
+	digits, _, ok := bytes.Cut(rest, []byte(" "))
+	if !ok {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(string(digits), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
+
+// DefaultMaxRings is the number of goroutine ring buffers a Tracer keeps
+// when none is specified.
+const DefaultMaxRings int = 64
+
+// ring_entry is a goroutine ring buffer in the LRU list of a Tracer.
+type ring_entry struct {
+	// id is the ID of the goroutine.
+	id uint64
+
+	// ring is the ring buffer.
+	ring *ring
+}
+
+// Tracer is an execution trace logger. Each goroutine writes into its own
+// fixed-size ring buffer so that only the most recent messages are kept;
+// they can be dumped on demand or when a panic is recovered.
+//
+// Like Verbose, a Tracer starts inactive; while inactive, tracing costs a
+// single atomic load.
+//
+// Trace finds the ring of the calling goroutine by parsing its stack header
+// and takes a lock shared by all goroutines, and only the rings of the most
+// recently active goroutines are kept (see NewTracer). Hot paths should use
+// a TraceHandle instead, which needs neither.
+type Tracer struct {
+	// isActive is true if the tracer records messages.
+	isActive atomic.Bool
+
+	// size is the number of messages kept per ring.
+	size int
+
+	// maxRings is the maximum number of goroutine rings kept.
+	maxRings int
+
+	// rings are the entries of the LRU list, keyed by goroutine ID.
+	rings map[uint64]*list.Element
+
+	// lru are the goroutine rings, from the most to the least recently used.
+	lru *list.List
+
+	// handles are the open handles.
+	handles map[*TraceHandle]struct{}
+
+	// mu is the mutex that protects rings, lru, and handles.
+	mu sync.Mutex
+}
+
+// NewTracer creates a new inactive Tracer.
+//
+// Parameters:
+//   - size: The number of messages kept per goroutine.
+//   - maxRings: The maximum number of goroutines whose messages are kept.
+//     When a new goroutine starts tracing past this limit, the ring of the
+//     least recently traced goroutine is dropped.
+//
+// Returns:
+//   - *Tracer: The new Tracer.
+//
+// Behaviors:
+//   - If size is less than 1, 1 is used instead.
+//   - If maxRings is less than 1, DefaultMaxRings is used instead.
+func NewTracer(size, maxRings int) *Tracer {
+	if size < 1 {
+		size = 1
+	}
+
+	if maxRings < 1 {
+		maxRings = DefaultMaxRings
+	}
+
+	t := &Tracer{
+		size:     size,
+		maxRings: maxRings,
+		rings:    make(map[uint64]*list.Element),
+		lru:      list.New(),
+		handles:  make(map[*TraceHandle]struct{}),
+	}
+
+	return t
+}
+
+// Activate activates or deactivates the tracer.
+//
+// Parameters:
+//   - active: The boolean that determines if the tracer records messages.
+func (t *Tracer) Activate(active bool) {
+	t.isActive.Store(active)
+}
+
+// IsActive returns true if the tracer records messages.
+//
+// Returns:
+//   - bool: True if the tracer is active, false otherwise.
+func (t *Tracer) IsActive() bool {
+	return t.isActive.Load()
+}
+
+// Trace records a formatted message in the ring buffer of the calling
+// goroutine.
+//
+// Parameters:
+//   - format: The format string.
+//   - args: The arguments of the format string.
+//
+// Behaviors:
+//   - If the tracer is not active, the message is not even formatted.
+//   - The ring of the least recently traced goroutine is dropped if the
+//     limit of rings is reached.
+func (t *Tracer) Trace(format string, args ...any) {
+	if !t.isActive.Load() {
+		return
+	}
+
+	msg := fmt.Sprintf(format, args...)
+	id := goroutine_id()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elem, ok := t.rings[id]
+	if ok {
+		t.lru.MoveToFront(elem)
+	} else {
+		if t.lru.Len() >= t.maxRings {
+			oldest := t.lru.Back()
+
+			t.lru.Remove(oldest)
+			delete(t.rings, oldest.Value.(*ring_entry).id)
+		}
+
+		entry := &ring_entry{
+			id: id,
+			ring: &ring{
+				messages: make([]string, t.size),
+			},
+		}
+
+		elem = t.lru.PushFront(entry)
+		t.rings[id] = elem
+	}
+
+	elem.Value.(*ring_entry).ring.add(msg)
+}
+
+// Handle creates a handle with its own ring buffer. The handle is meant to
+// be owned by a single goroutine (or a single task), and tracing through it
+// takes neither the stack of the goroutine nor the lock of the tracer.
+//
+// Parameters:
+//   - name: The name of the handle, used by DumpTo.
+//
+// Returns:
+//   - *TraceHandle: The new handle. Close it when done to release its ring.
+func (t *Tracer) Handle(name string) *TraceHandle {
+	h := &TraceHandle{
+		tracer: t,
+		name:   name,
+		ring: &ring{
+			messages: make([]string, t.size),
+		},
+	}
+
+	t.mu.Lock()
+	t.handles[h] = struct{}{}
+	t.mu.Unlock()
+
+	return h
+}
+
+// DumpTo writes the recorded messages to w, grouped by handle and then by
+// goroutine, and from the oldest to the newest.
+//
+// Parameters:
+//   - w: The writer to write the messages to.
+//
+// Returns:
+//   - error: An error if writing fails.
+//
+// Behaviors:
+//   - If w is nil, nothing is written.
+func (t *Tracer) DumpTo(w io.Writer) error {
+	if w == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	handles := make([]*TraceHandle, 0, len(t.handles))
+	for h := range t.handles {
+		handles = append(handles, h)
+	}
+
+	slices.SortFunc(handles, func(a, b *TraceHandle) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	for _, h := range handles {
+		h.mu.Lock()
+		msgs := h.ring.ordered()
+		h.mu.Unlock()
+
+		err := dump_ring(w, fmt.Sprintf("handle %q", h.name), msgs)
+		if err != nil {
+			return err
+		}
+	}
+
+	ids := make([]uint64, 0, len(t.rings))
+	for id := range t.rings {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		msgs := t.rings[id].Value.(*ring_entry).ring.ordered()
+
+		err := dump_ring(w, fmt.Sprintf("goroutine %d", id), msgs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dump_ring writes the messages of a ring under a title.
+//
+// Parameters:
+//   - w: The writer.
+//   - title: The title.
+//   - msgs: The messages.
+//
+// Returns:
+//   - error: An error if writing fails.
+func dump_ring(w io.Writer, title string, msgs []string) error {
+	_, err := fmt.Fprintf(w, "%s:\n", title)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		_, err := fmt.Fprintf(w, "\t%s\n", msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reset discards all the recorded messages, including the ones of the
+// open handles.
+func (t *Tracer) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rings = make(map[uint64]*list.Element)
+	t.lru.Init()
+
+	for h := range t.handles {
+		h.mu.Lock()
+		h.ring.reset()
+		h.mu.Unlock()
+	}
+}
+
+// TraceHandle is a ring buffer of a Tracer owned by a single goroutine or
+// task. (see Tracer.Handle)
+type TraceHandle struct {
+	// tracer is the tracer the handle belongs to.
+	tracer *Tracer
+
+	// name is the name of the handle.
+	name string
+
+	// ring is the ring buffer of the handle.
+	ring *ring
+
+	// mu is the mutex that protects ring. It is only contended while the
+	// tracer dumps or resets the messages.
+	mu sync.Mutex
+}
+
+// Trace records a formatted message in the ring buffer of the handle.
+//
+// Parameters:
+//   - format: The format string.
+//   - args: The arguments of the format string.
+//
+// Behaviors:
+//   - If the tracer is not active, the message is not even formatted.
+func (h *TraceHandle) Trace(format string, args ...any) {
+	if !h.tracer.isActive.Load() {
+		return
+	}
+
+	msg := fmt.Sprintf(format, args...)
+
+	h.mu.Lock()
+	h.ring.add(msg)
+	h.mu.Unlock()
+}
+
+// Close releases the handle; its messages are no longer dumped. Tracing
+// through a closed handle is allowed but has no visible effect.
+func (h *TraceHandle) Close() {
+	h.tracer.mu.Lock()
+	defer h.tracer.mu.Unlock()
+
+	delete(h.tracer.handles, h)
+}
+
+// RecoverFromPanic recovers from a panic, dumps the recorded messages to w,
+// and stores the panic as an error of type *common.ErrPanic in err. It must
+// be called directly with defer.
+//
+// Parameters:
+//   - w: The writer to dump the messages to.
+//   - err: The error to set if a panic occurred. May be nil.
+//
+// Example:
+//
+//	func Process(t *Tracer) (err error) {
+//		defer t.RecoverFromPanic(os.Stderr, &err)
+//
+//		// ...
+//	}
+func (t *Tracer) RecoverFromPanic(w io.Writer, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	_ = t.DumpTo(w)
+
+	if err != nil {
+		*err = uc.NewErrPanic(r)
+	}
+}
@@ -0,0 +1,148 @@
+package Debugging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+func TestTracerBoundsRings(t *testing.T) {
+	tracer := NewTracer(4, 8)
+	tracer.Activate(true)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			tracer.Trace("message %d", i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	var builder strings.Builder
+
+	err := tracer.DumpTo(&builder)
+	if err != nil {
+		t.Fatalf("DumpTo failed: %s", err.Error())
+	}
+
+	if count := strings.Count(builder.String(), "goroutine "); count > 8 {
+		t.Errorf("Trace failed: expected at most %d rings, got %d", 8, count)
+	}
+}
+
+func TestTraceHandle(t *testing.T) {
+	tracer := NewTracer(2, 0)
+
+	h := tracer.Handle("parser")
+	h.Trace("ignored while inactive")
+
+	tracer.Activate(true)
+
+	h.Trace("a")
+	h.Trace("b")
+	h.Trace("c")
+
+	var builder strings.Builder
+
+	_ = tracer.DumpTo(&builder)
+
+	expected := "handle \"parser\":\n\tb\n\tc\n"
+	if got := builder.String(); got != expected {
+		t.Errorf("DumpTo failed: expected %q, got %q", expected, got)
+	}
+
+	h.Close()
+	builder.Reset()
+
+	_ = tracer.DumpTo(&builder)
+
+	if got := builder.String(); got != "" {
+		t.Errorf("Close failed: expected nothing to be dumped, got %q", got)
+	}
+}
+
+func TestTracerRecoverFromPanic(t *testing.T) {
+	tracer := NewTracer(4, 0)
+	tracer.Activate(true)
+
+	var builder strings.Builder
+
+	var id uint64
+
+	process := func() (err error) {
+		defer tracer.RecoverFromPanic(&builder, &err)
+
+		id = goroutine_id()
+		tracer.Trace("before the panic")
+
+		panic("boom")
+	}
+
+	done := make(chan error)
+
+	go func() {
+		done <- process()
+	}()
+
+	err := <-done
+
+	var p *uc.ErrPanic
+
+	if !errors.As(err, &p) || p.Value != "boom" {
+		t.Fatalf("RecoverFromPanic failed: expected a panic with %q, got %v", "boom", err)
+	}
+
+	if id == 0 {
+		t.Fatalf("goroutine_id failed: expected an ID, got 0")
+	}
+
+	expected := fmt.Sprintf("goroutine %d:\n\tbefore the panic\n", id)
+
+	if got := builder.String(); got != expected {
+		t.Errorf("RecoverFromPanic failed: expected %q, got %q", expected, got)
+	}
+
+	// Without a panic, nothing is dumped and err is left alone.
+	builder.Reset()
+
+	err = func() (err error) {
+		defer tracer.RecoverFromPanic(&builder, &err)
+
+		return nil
+	}()
+
+	if err != nil || builder.Len() != 0 {
+		t.Errorf("RecoverFromPanic failed: expected nothing, got %v and %q", err, builder.String())
+	}
+}
+
+func TestParseGoroutineID(t *testing.T) {
+	tests := []struct {
+		stack    string
+		expected uint64
+	}{
+		{"goroutine 18 [running]:\nmain.main()", 18},
+		{"goroutine 1 [chan receive]:", 1},
+		{"goroutine 18", 0},
+		{"goroutine x [running]:", 0},
+		{"goroutine -1 [running]:", 0},
+		{"thread 18 [running]:", 0},
+		{"", 0},
+	}
+
+	for _, test := range tests {
+		if got := parse_goroutine_id([]byte(test.stack)); got != test.expected {
+			t.Errorf("parse_goroutine_id(%q) failed: expected %d, got %d", test.stack, test.expected, got)
+		}
+	}
+}
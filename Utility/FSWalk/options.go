@@ -0,0 +1,90 @@
+package FSWalk
+
+import (
+	"path"
+)
+
+// SymlinkPolicy is an enum that tells the walker what to do with symbolic
+// links.
+type SymlinkPolicy int8
+
+const (
+	// SkipSymlinks ignores symbolic links altogether.
+	SkipSymlinks SymlinkPolicy = iota
+
+	// ListSymlinks yields symbolic links as entries but never follows them.
+	ListSymlinks
+
+	// FollowSymlinks follows symbolic links as if they were their target.
+	// Links that lead back to an already visited directory are not followed
+	// again.
+	FollowSymlinks
+)
+
+// String implements the fmt.Stringer interface.
+func (p SymlinkPolicy) String() string {
+	return [...]string{
+		"skip symlinks",
+		"list symlinks",
+		"follow symlinks",
+	}[p]
+}
+
+// Options are the options of a walk.
+type Options struct {
+	// Include are the glob patterns (see path.Match) that entries must match
+	// to be yielded. A pattern matches if it matches either the name of the
+	// entry or its slash-separated path relative to the root. If empty,
+	// every entry is included.
+	Include []string
+
+	// Exclude are the glob patterns of the entries to skip; they take
+	// precedence over Include. Excluded directories are not descended into.
+	Exclude []string
+
+	// MaxDepth is the maximum depth to walk, the entries of the root being
+	// at depth 1. Values less than 1 mean no limit.
+	MaxDepth int
+
+	// Symlinks is the policy for symbolic links.
+	Symlinks SymlinkPolicy
+
+	// IncludeDirs is true if directories are yielded as well as files.
+	IncludeDirs bool
+
+	// Errors is the channel where errors encountered while walking (e.g.,
+	// unreadable directories) are sent. The walk continues after each error.
+	// If nil, such errors are ignored.
+	//
+	// WARNING: Sends are blocking, so the channel must either be buffered
+	// enough or be drained concurrently.
+	Errors chan<- error
+}
+
+// match_any checks whether an entry matches any of the given patterns.
+//
+// Parameters:
+//   - patterns: The glob patterns.
+//   - name: The name of the entry.
+//   - rel: The slash-separated path of the entry relative to the root.
+//
+// Returns:
+//   - bool: True if any pattern matches, false otherwise.
+//
+// Behaviors:
+//   - Malformed patterns never match.
+func match_any(patterns []string, name, rel string) bool {
+	for _, pattern := range patterns {
+		ok, _ := path.Match(pattern, name)
+		if ok {
+			return true
+		}
+
+		ok, _ = path.Match(pattern, rel)
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
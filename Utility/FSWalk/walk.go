@@ -0,0 +1,240 @@
+package FSWalk
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	fm "github.com/PlayerR9/MyGoLib/Utility/FileManager"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// FileEntry is an entry yielded by a walk.
+type FileEntry struct {
+	// Path is the path of the entry, joined to the root.
+	Path string
+
+	// RelPath is the slash-separated path of the entry relative to the root.
+	RelPath string
+
+	// Depth is the depth of the entry; the entries of the root are at depth 1.
+	Depth int
+
+	// IsDir is true if the entry is a directory, or a followed symbolic link
+	// to a directory.
+	IsDir bool
+
+	// fs.DirEntry is the directory entry.
+	fs.DirEntry
+}
+
+// frame is a directory being walked.
+type frame struct {
+	// dir is the path of the directory.
+	dir string
+
+	// rel is the slash-separated path of the directory relative to the root.
+	rel string
+
+	// depth is the depth of the directory.
+	depth int
+
+	// entries are the entries of the directory.
+	entries []fs.DirEntry
+
+	// idx is the index of the next entry to look at.
+	idx int
+}
+
+// Walker is an iterator that walks a directory tree in a depth-first,
+// pre-order manner without using recursion. Directories are read lazily.
+type Walker struct {
+	// root is the root of the walk.
+	root string
+
+	// opts are the options of the walk.
+	opts Options
+
+	// stack are the directories being walked.
+	stack []*frame
+
+	// visited are the resolved paths of the directories already walked.
+	// Only used when following symbolic links.
+	visited map[string]bool
+}
+
+// report sends an error on the error channel, if any.
+//
+// Parameters:
+//   - err: The error to send.
+func (w *Walker) report(err error) {
+	if w.opts.Errors != nil {
+		w.opts.Errors <- err
+	}
+}
+
+// push reads a directory and pushes it on the stack.
+//
+// Parameters:
+//   - dir: The path of the directory.
+//   - rel: The slash-separated path of the directory relative to the root.
+//   - depth: The depth of the directory.
+func (w *Walker) push(dir, rel string, depth int) {
+	if w.opts.Symlinks == FollowSymlinks {
+		resolved, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			w.report(err)
+			return
+		}
+
+		if w.visited[resolved] {
+			return
+		}
+
+		w.visited[resolved] = true
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		w.report(err)
+
+		if len(entries) == 0 {
+			return
+		}
+	}
+
+	f := &frame{
+		dir:     dir,
+		rel:     rel,
+		depth:   depth,
+		entries: entries,
+	}
+
+	w.stack = append(w.stack, f)
+}
+
+// Consume implements the common.Iterater interface.
+//
+// Returns:
+//   - FileEntry: The next entry.
+//   - error: An error of type *common.ErrExhaustedIter if there are no more
+//     entries.
+func (w *Walker) Consume() (FileEntry, error) {
+	for len(w.stack) > 0 {
+		top := w.stack[len(w.stack)-1]
+
+		if top.idx >= len(top.entries) {
+			w.stack = w.stack[:len(w.stack)-1]
+			continue
+		}
+
+		de := top.entries[top.idx]
+		top.idx++
+
+		name := de.Name()
+
+		entry := FileEntry{
+			Path:     filepath.Join(top.dir, name),
+			RelPath:  name,
+			Depth:    top.depth + 1,
+			IsDir:    de.IsDir(),
+			DirEntry: de,
+		}
+
+		if top.rel != "" {
+			entry.RelPath = top.rel + "/" + name
+		}
+
+		if de.Type()&fs.ModeSymlink != 0 {
+			switch w.opts.Symlinks {
+			case SkipSymlinks:
+				continue
+			case FollowSymlinks:
+				info, err := os.Stat(entry.Path)
+				if err != nil {
+					w.report(err)
+					continue
+				}
+
+				entry.IsDir = info.IsDir()
+			}
+		}
+
+		if match_any(w.opts.Exclude, name, entry.RelPath) {
+			continue
+		}
+
+		if entry.IsDir && (w.opts.MaxDepth < 1 || entry.Depth < w.opts.MaxDepth) {
+			w.push(entry.Path, entry.RelPath, entry.Depth)
+		}
+
+		if entry.IsDir && !w.opts.IncludeDirs {
+			continue
+		}
+
+		if len(w.opts.Include) > 0 && !match_any(w.opts.Include, name, entry.RelPath) {
+			continue
+		}
+
+		return entry, nil
+	}
+
+	return FileEntry{}, uc.NewErrExhaustedIter()
+}
+
+// Restart implements the common.Iterater interface.
+//
+// The root is read again, so changes made to the file system since the
+// walk started are taken into account.
+func (w *Walker) Restart() {
+	w.stack = w.stack[:0]
+
+	if w.opts.Symlinks == FollowSymlinks {
+		w.visited = make(map[string]bool)
+	}
+
+	w.push(w.root, "", 0)
+}
+
+// Walk walks the directory tree rooted at root.
+//
+// Parameters:
+//   - root: The path of the directory to walk.
+//   - opts: The options of the walk.
+//
+// Returns:
+//   - common.Iterater[FileEntry]: An iterator over the entries of the tree.
+//   - error: An error if root cannot be accessed or is not a directory.
+//
+// Example:
+//
+//	iter, err := Walk(".", Options{Include: []string{"*.go"}})
+//	if err != nil {
+//		return err
+//	}
+//
+//	for {
+//		entry, err := iter.Consume()
+//		if err != nil {
+//			break
+//		}
+//
+//		fmt.Println(entry.RelPath)
+//	}
+func Walk(root string, opts Options) (uc.Iterater[FileEntry], error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return nil, fm.NewErrPathNot(root, "a directory")
+	}
+
+	w := &Walker{
+		root: root,
+		opts: opts,
+	}
+
+	w.Restart()
+
+	return w, nil
+}
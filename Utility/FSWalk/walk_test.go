@@ -0,0 +1,54 @@
+package FSWalk
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	root := t.TempDir()
+
+	for _, name := range []string{"a.go", "b.txt", "sub/c.go", "sub/deep/d.go", "vendor/e.go"} {
+		loc := filepath.Join(root, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(loc), 0755)
+		if err != nil {
+			t.Fatalf("MkdirAll failed: %s", err.Error())
+		}
+
+		err = os.WriteFile(loc, nil, 0644)
+		if err != nil {
+			t.Fatalf("WriteFile failed: %s", err.Error())
+		}
+	}
+
+	opts := Options{
+		Include:  []string{"*.go"},
+		Exclude:  []string{"vendor"},
+		MaxDepth: 2,
+	}
+
+	iter, err := Walk(root, opts)
+	if err != nil {
+		t.Fatalf("Walk failed: %s", err.Error())
+	}
+
+	var got []string
+
+	for {
+		entry, err := iter.Consume()
+		if err != nil {
+			break
+		}
+
+		got = append(got, entry.RelPath)
+	}
+
+	expected := []string{"a.go", "sub/c.go"}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("Walk failed: expected %v, got %v", expected, got)
+	}
+}
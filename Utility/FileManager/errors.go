@@ -1,6 +1,8 @@
 package FileManager
 
-import "strings"
+import (
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
 
 // ErrFileNotOpen is an error type for when a file was not opened.
 type ErrFileNotOpen struct{}
@@ -8,8 +10,18 @@ type ErrFileNotOpen struct{}
 // Error implements the error interface.
 //
 // Message: "file was not opened"
+//
+// The message can be changed with errors.SetMessage(errors.KeyFileNotOpen, ...).
 func (e *ErrFileNotOpen) Error() string {
-	return "file was not opened"
+	return ers.FormatMessage(ers.KeyFileNotOpen)
+}
+
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - errors.MessageKey: The key of the message.
+func (e *ErrFileNotOpen) MessageKey() ers.MessageKey {
+	return ers.KeyFileNotOpen
 }
 
 // NewErrFileNotOpen creates a new ErrFileNotOpen error.
@@ -33,17 +45,18 @@ type ErrPathNot struct {
 // Error implements the error interface.
 //
 // Message: "path {Path} is not {Expected}"
+//
+// The message can be changed with errors.SetMessage(errors.KeyPathNot, ...).
 func (e *ErrPathNot) Error() string {
-	values := []string{
-		"path",
-		e.Path,
-		"is not",
-		e.Expected,
-	}
-
-	msg := strings.Join(values, " ")
+	return ers.FormatMessage(ers.KeyPathNot, "path", e.Path, "expected", e.Expected)
+}
 
-	return msg
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - errors.MessageKey: The key of the message.
+func (e *ErrPathNot) MessageKey() ers.MessageKey {
+	return ers.KeyPathNot
 }
 
 // NewErrPathNot creates a new ErrPathNot error.
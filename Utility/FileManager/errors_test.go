@@ -0,0 +1,30 @@
+package FileManager
+
+import (
+	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+func TestErrorMessages(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{NewErrFileNotOpen(), "file was not opened"},
+		{NewErrPathNot("/tmp/x", "a directory"), "path /tmp/x is not a directory"},
+	}
+
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.expected {
+			t.Errorf("Error failed: expected %q, got %q", test.expected, got)
+		}
+	}
+
+	ers.SetMessage(ers.KeyPathNot, "{path}: not {expected}")
+	defer ers.ResetMessages()
+
+	if got := NewErrPathNot("/tmp/x", "a directory").Error(); got != "/tmp/x: not a directory" {
+		t.Errorf("SetMessage failed: expected %q, got %q", "/tmp/x: not a directory", got)
+	}
+}
@@ -0,0 +1,25 @@
+package General
+
+// Cleaner is an interface for values that hold resources to release once
+// they are no longer used. (e.g., open files or pooled buffers)
+type Cleaner interface {
+	// Cleanup releases the resources held by the value.
+	Cleanup()
+}
+
+// CleanupAll calls Cleanup on each of the given values.
+//
+// Parameters:
+//   - values: The values to clean up.
+//
+// Behaviors:
+//   - Nil values are skipped.
+func CleanupAll[T Cleaner](values ...T) {
+	for _, value := range values {
+		if any(value) == nil || IsNil(value) {
+			continue
+		}
+
+		value.Cleanup()
+	}
+}
@@ -0,0 +1,25 @@
+package General
+
+import (
+	"testing"
+)
+
+// counter is a Cleaner that counts how many times it was cleaned up.
+type counter struct {
+	n int
+}
+
+func (c *counter) Cleanup() {
+	c.n++
+}
+
+func TestCleanupAll(t *testing.T) {
+	c := &counter{}
+
+	CleanupAll(c, nil, c)
+	CleanupAll[Cleaner](nil, c)
+
+	if c.n != 3 {
+		t.Errorf("CleanupAll failed: expected %d, got %d", 3, c.n)
+	}
+}
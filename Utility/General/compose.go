@@ -0,0 +1,178 @@
+package General
+
+import (
+	luh "github.com/PlayerR9/lib_units/helpers"
+	luint "github.com/PlayerR9/lib_units/ints"
+)
+
+// EvalManyFunc is a function that evaluates one element into many.
+//
+// Parameters:
+//   - elem: The element to evaluate.
+//
+// Returns:
+//   - []R: The results of the evaluation.
+//   - error: An error if the evaluation failed.
+type EvalManyFunc[E, R any] func(elem E) ([]R, error)
+
+// Compose returns a function that evaluates f and then g on its result.
+//
+// Parameters:
+//   - f: The first function.
+//   - g: The second function.
+//
+// Returns:
+//   - helpers.EvalOneFunc[A, C]: The composed function. Nil if f or g is nil.
+//
+// Behaviors:
+//   - If f fails, g is not called and f's error is returned.
+//
+// Example:
+//
+//	parse := func(s string) (int, error) { return strconv.Atoi(s) }
+//	half := func(n int) (float64, error) { return float64(n) / 2, nil }
+//
+//	f := Compose(parse, half)
+//	fmt.Println(f("3")) // 1.5 <nil>
+func Compose[A, B, C any](f luh.EvalOneFunc[A, B], g luh.EvalOneFunc[B, C]) luh.EvalOneFunc[A, C] {
+	if f == nil || g == nil {
+		return nil
+	}
+
+	return func(elem A) (C, error) {
+		res, err := f(elem)
+		if err != nil {
+			return *new(C), err
+		}
+
+		return g(res)
+	}
+}
+
+// Chain returns a function that evaluates f and then g on each of its
+// results, concatenating the results of g.
+//
+// Parameters:
+//   - f: The first function.
+//   - g: The second function.
+//
+// Returns:
+//   - EvalManyFunc[A, C]: The chained function. Nil if f or g is nil.
+//
+// Behaviors:
+//   - The first error, either from f or from g, stops the evaluation and is
+//     returned along with no results.
+//
+// Example:
+//
+//	split := func(s string) ([]string, error) { return strings.Fields(s), nil }
+//	chars := func(s string) ([]rune, error) { return []rune(s), nil }
+//
+//	f := Chain(split, chars)
+//	fmt.Println(f("ab c")) // [97 98 99] <nil>
+func Chain[A, B, C any](f EvalManyFunc[A, B], g EvalManyFunc[B, C]) EvalManyFunc[A, C] {
+	if f == nil || g == nil {
+		return nil
+	}
+
+	return func(elem A) ([]C, error) {
+		mids, err := f(elem)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []C
+
+		for _, mid := range mids {
+			res, err := g(mid)
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, res...)
+		}
+
+		return results, nil
+	}
+}
+
+// Pipeline returns a function that evaluates stages in order, each on the
+// result of the previous one.
+//
+// Parameters:
+//   - stages: The stages. Nil stages are skipped.
+//
+// Returns:
+//   - helpers.EvalOneFunc[T, T]: The pipeline. With no stages, it returns
+//     its argument as is.
+//
+// Behaviors:
+//   - The first stage that fails stops the pipeline, and its error is
+//     returned. Wrap the stages with WithErrAt to know which one failed.
+//
+// Example:
+//
+//	trim := func(s string) (string, error) { return strings.TrimSpace(s), nil }
+//	upper := func(s string) (string, error) { return strings.ToUpper(s), nil }
+//
+//	p := Pipeline(trim, upper)
+//	fmt.Println(p(" go ")) // GO <nil>
+func Pipeline[T any](stages ...luh.EvalOneFunc[T, T]) luh.EvalOneFunc[T, T] {
+	var funcs []luh.EvalOneFunc[T, T]
+
+	for _, stage := range stages {
+		if stage != nil {
+			funcs = append(funcs, stage)
+		}
+	}
+
+	return func(elem T) (T, error) {
+		for _, stage := range funcs {
+			var err error
+
+			elem, err = stage(elem)
+			if err != nil {
+				return *new(T), err
+			}
+		}
+
+		return elem, nil
+	}
+}
+
+// WithErrAt returns a function that evaluates f and wraps its error, if any,
+// in an *ints.ErrAt; so a failure can be traced to its stage in a Compose
+// or a Pipeline.
+//
+// Parameters:
+//   - index: The position of the stage, as shown in the error message;
+//     so 1 gives "1st <name>".
+//   - name: The name of the stage. If empty, "index" is used.
+//   - f: The function.
+//
+// Returns:
+//   - helpers.EvalOneFunc[E, R]: The wrapped function. Nil if f is nil.
+//
+// Example:
+//
+//	p := Pipeline(
+//		WithErrAt(1, "stage", trim),
+//		WithErrAt(2, "stage", validate),
+//	)
+//
+//	_, err := p("")
+//	fmt.Println(err) // 2nd stage is invalid: <validate's error>
+func WithErrAt[E, R any](index int, name string, f luh.EvalOneFunc[E, R]) luh.EvalOneFunc[E, R] {
+	if f == nil {
+		return nil
+	}
+
+	return func(elem E) (R, error) {
+		res, err := f(elem)
+		if err != nil {
+			return *new(R), luint.NewErrAt(index, name, err)
+		}
+
+		return res, nil
+	}
+}
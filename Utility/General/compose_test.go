@@ -0,0 +1,117 @@
+package General
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	luint "github.com/PlayerR9/lib_units/ints"
+)
+
+func TestCompose(t *testing.T) {
+	f := Compose(strconv.Atoi, func(n int) (int, error) {
+		return n * 2, nil
+	})
+
+	res, err := f("21")
+	if err != nil {
+		t.Fatalf("Compose failed: %s", err.Error())
+	}
+
+	if res != 42 {
+		t.Errorf("Compose failed: expected %d, got %d", 42, res)
+	}
+
+	_, err = f("x")
+	if err == nil {
+		t.Errorf("Compose failed: expected error, got nil")
+	}
+}
+
+func TestChain(t *testing.T) {
+	split := func(s string) ([]string, error) {
+		return strings.Fields(s), nil
+	}
+
+	chars := func(s string) ([]rune, error) {
+		if s == "!" {
+			return nil, errors.New("bang")
+		}
+
+		return []rune(s), nil
+	}
+
+	f := Chain(split, chars)
+
+	res, err := f("ab c")
+	if err != nil {
+		t.Fatalf("Chain failed: %s", err.Error())
+	}
+
+	expected := []rune("abc")
+
+	if !slices.Equal(res, expected) {
+		t.Errorf("Chain failed: expected %v, got %v", expected, res)
+	}
+
+	res, err = f("ab ! c")
+	if err == nil || res != nil {
+		t.Errorf("Chain failed: expected error and no results, got %v, %v", res, err)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	var calls int
+
+	trim := func(s string) (string, error) {
+		calls++
+		return strings.TrimSpace(s), nil
+	}
+
+	check := func(s string) (string, error) {
+		calls++
+
+		if s == "" {
+			return "", errors.New("empty")
+		}
+
+		return s, nil
+	}
+
+	upper := func(s string) (string, error) {
+		calls++
+		return strings.ToUpper(s), nil
+	}
+
+	p := Pipeline(WithErrAt(1, "stage", trim), nil, WithErrAt(2, "stage", check), WithErrAt(3, "stage", upper))
+
+	res, err := p(" go ")
+	if err != nil {
+		t.Fatalf("Pipeline failed: %s", err.Error())
+	}
+
+	if res != "GO" {
+		t.Errorf("Pipeline failed: expected %q, got %q", "GO", res)
+	}
+
+	calls = 0
+
+	_, err = p("  ")
+
+	var at *luint.ErrAt
+
+	if !errors.As(err, &at) || at.Index != 2 {
+		t.Errorf("Pipeline failed: expected error at stage 2, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Pipeline failed: expected %d calls, got %d", 2, calls)
+	}
+
+	res, err = Pipeline[string]()("same")
+	if err != nil || res != "same" {
+		t.Errorf("Pipeline failed: expected %q, got %q, %v", "same", res, err)
+	}
+}
@@ -0,0 +1,54 @@
+package General
+
+import (
+	"reflect"
+)
+
+// Equaler is an interface for values that define their own notion of
+// equality. (e.g., values that hold caches or pointers that must not be
+// compared)
+type Equaler interface {
+	// Equals checks whether the value is equal to another value.
+	//
+	// Parameters:
+	//   - other: The other value. It can be of any type.
+	//
+	// Returns:
+	//   - bool: True if the values are equal, false otherwise.
+	Equals(other any) bool
+}
+
+// EqualsOf checks whether two values are equal.
+//
+// Parameters:
+//   - a: The first value.
+//   - b: The second value.
+//
+// Returns:
+//   - bool: True if the values are equal, false otherwise.
+//
+// Behaviors:
+//   - A nil value, typed or not, is only equal to another nil value.
+//   - If a implements Equaler, a.Equals(b) is used; otherwise, if b does,
+//     b.Equals(a) is used.
+//   - Otherwise, the values are compared with reflect.DeepEqual.
+//
+// Example:
+//
+//	fmt.Println(EqualsOf([]int{1, 2}, []int{1, 2})) // true
+func EqualsOf(a, b any) bool {
+	aNil := a == nil || IsNil(a)
+	bNil := b == nil || IsNil(b)
+
+	if aNil || bNil {
+		return aNil && bNil
+	}
+
+	if e, ok := a.(Equaler); ok {
+		return e.Equals(b)
+	} else if e, ok := b.(Equaler); ok {
+		return e.Equals(a)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,41 @@
+package General
+
+import (
+	"strings"
+	"testing"
+)
+
+// name is an Equaler that compares names case-insensitively.
+type name string
+
+func (n name) Equals(other any) bool {
+	o, ok := other.(name)
+	return ok && strings.EqualFold(string(n), string(o))
+}
+
+func TestEqualsOf(t *testing.T) {
+	var nilPtr *int
+
+	one := 1
+
+	tests := []struct {
+		a, b     any
+		expected bool
+	}{
+		{nil, nil, true},
+		{nilPtr, nil, true},
+		{nilPtr, &one, false},
+		{name("Go"), name("GO"), true},
+		{"GO", name("go"), false},
+		{name("go"), "go", false},
+		{[]int{1, 2}, []int{1, 2}, true},
+		{map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+		{1, int64(1), false},
+	}
+
+	for _, test := range tests {
+		if got := EqualsOf(test.a, test.b); got != test.expected {
+			t.Errorf("EqualsOf(%#v, %#v) failed: expected %t, got %t", test.a, test.b, test.expected, got)
+		}
+	}
+}
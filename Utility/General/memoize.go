@@ -0,0 +1,141 @@
+package General
+
+import (
+	"container/list"
+	"sync"
+)
+
+// memo_entry is an entry of the cache of Memoize.
+type memo_entry[K comparable, V any] struct {
+	// key is the argument.
+	key K
+
+	// value is the result for the argument.
+	value V
+}
+
+// memo_cache is the cache of Memoize.
+type memo_cache[K comparable, V any] struct {
+	// entries are the elements of lru, keyed by argument.
+	entries map[K]*list.Element
+
+	// lru is the list of *memo_entry, from the most to the least recently
+	// used.
+	lru *list.List
+
+	// maxEntries is the maximum number of entries. Not positive if the
+	// cache is unbounded.
+	maxEntries int
+
+	// mu is the mutex that protects entries and lru.
+	mu sync.Mutex
+}
+
+// get returns the cached result for an argument, if any.
+//
+// Parameters:
+//   - key: The argument.
+//
+// Returns:
+//   - V: The cached result.
+//   - bool: True if the result was cached, false otherwise.
+func (c *memo_cache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return elem.Value.(*memo_entry[K, V]).value, true
+}
+
+// put caches the result for an argument, evicting the least recently used
+// entry if the cache is full.
+//
+// Parameters:
+//   - key: The argument.
+//   - value: The result.
+func (c *memo_cache[K, V]) put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok {
+		elem.Value.(*memo_entry[K, V]).value = value
+		c.lru.MoveToFront(elem)
+
+		return
+	}
+
+	if c.maxEntries > 0 && c.lru.Len() >= c.maxEntries {
+		oldest := c.lru.Back()
+
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memo_entry[K, V]).key)
+	}
+
+	c.entries[key] = c.lru.PushFront(&memo_entry[K, V]{
+		key:   key,
+		value: value,
+	})
+}
+
+// Memoize returns a function that caches the results of another.
+//
+// Parameters:
+//   - f: The function to memoize. It must be pure: its result must only
+//     depend on its argument.
+//   - maxEntries: The maximum number of cached results. Once reached, the
+//     least recently used result is evicted. If not positive, the cache is
+//     unbounded.
+//
+// Returns:
+//   - func(K) (V, error): The memoized function. Nil if f is nil.
+//
+// Behaviors:
+//   - Errors are not cached: f is called again for an argument whose last
+//     call failed.
+//   - The memoized function is safe for concurrent use. f is called without
+//     holding any lock, so concurrent calls for the same uncached argument
+//     may each call f.
+//
+// Example:
+//
+//	square := Memoize(func(x int) (int, error) {
+//		fmt.Println("computing", x)
+//		return x * x, nil
+//	}, 100)
+//
+//	square(3) // computing 3
+//	square(3) // (cached)
+func Memoize[K comparable, V any](f func(K) (V, error), maxEntries int) func(K) (V, error) {
+	if f == nil {
+		return nil
+	}
+
+	cache := &memo_cache[K, V]{
+		entries:    make(map[K]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
+	}
+
+	return func(key K) (V, error) {
+		value, ok := cache.get(key)
+		if ok {
+			return value, nil
+		}
+
+		value, err := f(key)
+		if err != nil {
+			return *new(V), err
+		}
+
+		cache.put(key, value)
+
+		return value, nil
+	}
+}
@@ -0,0 +1,51 @@
+package General
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := make(map[int]int)
+
+	square := Memoize(func(x int) (int, error) {
+		calls[x]++
+
+		if x < 0 {
+			return 0, errors.New("negative")
+		}
+
+		return x * x, nil
+	}, 2)
+
+	for _, x := range []int{1, 2, 1, 3, 1, 2} {
+		value, err := square(x)
+		if err != nil {
+			t.Fatalf("Memoize failed: %s", err.Error())
+		}
+
+		if value != x*x {
+			t.Errorf("Memoize failed: expected %d, got %d", x*x, value)
+		}
+	}
+
+	// 2 was evicted by 3, being the least recently used; 1 never was.
+	expected := map[int]int{1: 1, 2: 2, 3: 1}
+
+	for x, n := range expected {
+		if calls[x] != n {
+			t.Errorf("Memoize failed: expected %d calls for %d, got %d", n, x, calls[x])
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := square(-1)
+		if err == nil {
+			t.Errorf("Memoize failed: expected error, got nil")
+		}
+	}
+
+	if calls[-1] != 2 {
+		t.Errorf("Memoize failed: expected errors not to be cached, got %d calls", calls[-1])
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 	uc "github.com/PlayerR9/lib_units/common"
 	luint "github.com/PlayerR9/lib_units/ints"
 	utch "github.com/PlayerR9/lib_units/runes"
@@ -59,7 +60,7 @@ func check_letter(c rune) (bool, bool) {
 //   - error: An error if the variable name is invalid.
 func IsValidName(variable_name string, keywords []string) error {
 	if variable_name == "" {
-		err := uc.NewErrEmpty(variable_name)
+		err := uc.NewErrEmpty("variable_name")
 		return err
 	}
 
@@ -92,7 +93,7 @@ func IsValidName(variable_name string, keywords []string) error {
 //   - *common.ErrAt: If the type name is invalid at a specific position.
 func MakeVariableName(type_name string) (string, error) {
 	if type_name == "" {
-		return "", uc.NewErrInvalidParameter("type_name", uc.NewErrEmpty(type_name))
+		return "", uc.NewErrInvalidParameter("type_name", uc.NewErrEmpty("type_name"))
 	}
 
 	chars, err := utch.StringToUtf8(type_name)
@@ -209,8 +210,26 @@ func fix_variable_name(var_name string, keywords []string, min int) (string, err
 //   - string: The fixed variable name.
 //   - bool: True if the variable name is not empty. False otherwise.
 func FixVariableName(variable_name string, keywords []string, min int, suffix string) (string, bool) {
+	var_name, err := FixVariableNameErr(variable_name, keywords, min, suffix)
+	return var_name, err == nil
+}
+
+// FixVariableNameErr is like FixVariableName but reports why the variable name
+// could not be fixed.
+//
+// Parameters:
+//   - variable_name: The variable name to fix.
+//   - keywords: The list of keywords to check against.
+//   - min: The minimum length of the variable name. If less than 1, the function uses 1.
+//   - suffix: The suffix to append to the variable name. If empty, the function uses "_".
+//
+// Returns:
+//   - string: The fixed variable name.
+//   - error: An error of type *common.ErrInvalidParameter that wraps
+//     errors.ErrEmptyInput if the variable name is empty.
+func FixVariableNameErr(variable_name string, keywords []string, min int, suffix string) (string, error) {
 	if variable_name == "" {
-		return "", false
+		return "", uc.NewErrInvalidParameter("variable_name", ers.ErrEmptyInput)
 	}
 
 	if min < 1 {
@@ -223,7 +242,7 @@ func FixVariableName(variable_name string, keywords []string, min int, suffix st
 
 	var_name, err := fix_variable_name(variable_name, keywords, min)
 	if err == nil {
-		return var_name, true
+		return var_name, nil
 	}
 
 	for {
@@ -231,7 +250,7 @@ func FixVariableName(variable_name string, keywords []string, min int, suffix st
 
 		err := IsValidName(variable_name, keywords)
 		if err == nil {
-			return variable_name, true
+			return variable_name, nil
 		}
 	}
 }
@@ -249,8 +268,26 @@ func FixVariableName(variable_name string, keywords []string, min int, suffix st
 //   - string: The fixed variable name.
 //   - bool: True if the variable name is not empty. False otherwise.
 func FixVarNameIncremental(variable_name string, keywords []string, min int, start int) (string, bool) {
+	var_name, err := FixVarNameIncrementalErr(variable_name, keywords, min, start)
+	return var_name, err == nil
+}
+
+// FixVarNameIncrementalErr is like FixVarNameIncremental but reports why the
+// variable name could not be fixed.
+//
+// Parameters:
+//   - variable_name: The variable name to fix.
+//   - keywords: The list of keywords to check against.
+//   - min: The minimum length of the variable name. If less than 1, the function uses 1.
+//   - start: The starting number to append to the variable name. If less than 0, the function uses 0.
+//
+// Returns:
+//   - string: The fixed variable name.
+//   - error: An error of type *common.ErrInvalidParameter that wraps
+//     errors.ErrEmptyInput if the variable name is empty.
+func FixVarNameIncrementalErr(variable_name string, keywords []string, min int, start int) (string, error) {
 	if variable_name == "" {
-		return "", false
+		return "", uc.NewErrInvalidParameter("variable_name", ers.ErrEmptyInput)
 	}
 
 	if min < 1 {
@@ -263,7 +300,7 @@ func FixVarNameIncremental(variable_name string, keywords []string, min int, sta
 
 	var_name, err := fix_variable_name(variable_name, keywords, min)
 	if err == nil {
-		return var_name, true
+		return var_name, nil
 	}
 
 	for i := start; ; i++ {
@@ -271,7 +308,7 @@ func FixVarNameIncremental(variable_name string, keywords []string, min int, sta
 
 		err := IsValidName(tmp, keywords)
 		if err == nil {
-			return tmp, true
+			return tmp, nil
 		}
 	}
 }
@@ -1,7 +1,10 @@
 package Go
 
 import (
+	"errors"
 	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
 )
 
 func TestMakeVariableName(t *testing.T) {
@@ -43,3 +46,24 @@ func TestFixVarNameIncremental(t *testing.T) {
 		t.Errorf("FixVarNameIncremental failed: expected %s, got %s", "tn2", res)
 	}
 }
+
+func TestFixVariableNameErr(t *testing.T) {
+	_, err := FixVariableNameErr("", nil, 2, "_")
+	if !errors.Is(err, ers.ErrEmptyInput) {
+		t.Errorf("FixVariableNameErr failed: expected %v, got %v", ers.ErrEmptyInput, err)
+	}
+
+	_, err = FixVarNameIncrementalErr("", nil, 2, 0)
+	if !errors.Is(err, ers.ErrEmptyInput) {
+		t.Errorf("FixVarNameIncrementalErr failed: expected %v, got %v", ers.ErrEmptyInput, err)
+	}
+
+	res, err := FixVarNameIncrementalErr("child", []string{"child"}, 5, 1)
+	if err != nil {
+		t.Fatalf("FixVarNameIncrementalErr failed: %s", err.Error())
+	}
+
+	if res != "child1" {
+		t.Errorf("FixVarNameIncrementalErr failed: expected %s, got %s", "child1", res)
+	}
+}
@@ -0,0 +1,338 @@
+package Iterators
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+	lup "github.com/PlayerR9/lib_units/pair"
+)
+
+// map_iter is the iterator returned by MapIter.
+type map_iter[T, U any] struct {
+	// source is the iterator being mapped.
+	source uc.Iterater[T]
+
+	// f is the mapping function.
+	f func(T) U
+}
+
+// Consume implements the common.Iterater interface.
+func (it *map_iter[T, U]) Consume() (U, error) {
+	value, err := it.source.Consume()
+	if err != nil {
+		return *new(U), err
+	}
+
+	return it.f(value), nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *map_iter[T, U]) Restart() {
+	it.source.Restart()
+}
+
+// MapIter returns an iterator that applies a function to each element of
+// another iterator.
+//
+// Parameters:
+//   - source: The source iterator.
+//   - f: The mapping function.
+//
+// Returns:
+//   - common.Iterater[U]: The mapped iterator.
+//
+// Behaviors:
+//   - If source or f is nil, an empty iterator is returned.
+//   - f is called lazily, once per consumed element.
+func MapIter[T, U any](source uc.Iterater[T], f func(T) U) uc.Iterater[U] {
+	if source == nil || f == nil {
+		return uc.NewSimpleIterator[U](nil)
+	}
+
+	return &map_iter[T, U]{
+		source: source,
+		f:      f,
+	}
+}
+
+// filter_iter is the iterator returned by FilterIter.
+type filter_iter[T any] struct {
+	// source is the iterator being filtered.
+	source uc.Iterater[T]
+
+	// pred is the predicate that elements must satisfy.
+	pred func(T) bool
+}
+
+// Consume implements the common.Iterater interface.
+func (it *filter_iter[T]) Consume() (T, error) {
+	for {
+		value, err := it.source.Consume()
+		if err != nil {
+			return *new(T), err
+		}
+
+		if it.pred(value) {
+			return value, nil
+		}
+	}
+}
+
+// Restart implements the common.Iterater interface.
+func (it *filter_iter[T]) Restart() {
+	it.source.Restart()
+}
+
+// FilterIter returns an iterator over the elements of another iterator that
+// satisfy a predicate.
+//
+// Parameters:
+//   - source: The source iterator.
+//   - pred: The predicate.
+//
+// Returns:
+//   - common.Iterater[T]: The filtered iterator.
+//
+// Behaviors:
+//   - If source is nil, an empty iterator is returned.
+//   - If pred is nil, source is returned as is.
+func FilterIter[T any](source uc.Iterater[T], pred func(T) bool) uc.Iterater[T] {
+	if source == nil {
+		return uc.NewSimpleIterator[T](nil)
+	} else if pred == nil {
+		return source
+	}
+
+	return &filter_iter[T]{
+		source: source,
+		pred:   pred,
+	}
+}
+
+// take_iter is the iterator returned by TakeIter.
+type take_iter[T any] struct {
+	// source is the source iterator.
+	source uc.Iterater[T]
+
+	// n is the maximum number of elements to yield.
+	n int
+
+	// count is the number of elements yielded so far.
+	count int
+}
+
+// Consume implements the common.Iterater interface.
+func (it *take_iter[T]) Consume() (T, error) {
+	if it.count >= it.n {
+		return *new(T), uc.NewErrExhaustedIter()
+	}
+
+	value, err := it.source.Consume()
+	if err != nil {
+		return *new(T), err
+	}
+
+	it.count++
+
+	return value, nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *take_iter[T]) Restart() {
+	it.source.Restart()
+	it.count = 0
+}
+
+// TakeIter returns an iterator over, at most, the first n elements of
+// another iterator.
+//
+// Parameters:
+//   - source: The source iterator.
+//   - n: The maximum number of elements.
+//
+// Returns:
+//   - common.Iterater[T]: The truncated iterator.
+//
+// Behaviors:
+//   - If source is nil or n is not positive, an empty iterator is returned.
+//   - The source is never consumed past the n-th element.
+func TakeIter[T any](source uc.Iterater[T], n int) uc.Iterater[T] {
+	if source == nil || n <= 0 {
+		return uc.NewSimpleIterator[T](nil)
+	}
+
+	return &take_iter[T]{
+		source: source,
+		n:      n,
+	}
+}
+
+// drop_iter is the iterator returned by DropIter.
+type drop_iter[T any] struct {
+	// source is the source iterator.
+	source uc.Iterater[T]
+
+	// n is the number of elements to skip.
+	n int
+
+	// skipped is true if the first n elements were skipped.
+	skipped bool
+}
+
+// Consume implements the common.Iterater interface.
+func (it *drop_iter[T]) Consume() (T, error) {
+	if !it.skipped {
+		for i := 0; i < it.n; i++ {
+			_, err := it.source.Consume()
+			if err != nil {
+				return *new(T), err
+			}
+		}
+
+		it.skipped = true
+	}
+
+	return it.source.Consume()
+}
+
+// Restart implements the common.Iterater interface.
+func (it *drop_iter[T]) Restart() {
+	it.source.Restart()
+	it.skipped = false
+}
+
+// DropIter returns an iterator that skips the first n elements of another
+// iterator.
+//
+// Parameters:
+//   - source: The source iterator.
+//   - n: The number of elements to skip.
+//
+// Returns:
+//   - common.Iterater[T]: The iterator.
+//
+// Behaviors:
+//   - If source is nil, an empty iterator is returned.
+//   - If n is not positive, source is returned as is.
+//   - The elements are skipped on the first call to Consume, not when the
+//     iterator is created.
+func DropIter[T any](source uc.Iterater[T], n int) uc.Iterater[T] {
+	if source == nil {
+		return uc.NewSimpleIterator[T](nil)
+	} else if n <= 0 {
+		return source
+	}
+
+	return &drop_iter[T]{
+		source: source,
+		n:      n,
+	}
+}
+
+// zip_iter is the iterator returned by ZipIter.
+type zip_iter[A, B any] struct {
+	// first is the iterator of the first elements.
+	first uc.Iterater[A]
+
+	// second is the iterator of the second elements.
+	second uc.Iterater[B]
+}
+
+// Consume implements the common.Iterater interface.
+func (it *zip_iter[A, B]) Consume() (lup.Pair[A, B], error) {
+	a, err := it.first.Consume()
+	if err != nil {
+		return lup.Pair[A, B]{}, err
+	}
+
+	b, err := it.second.Consume()
+	if err != nil {
+		return lup.Pair[A, B]{}, err
+	}
+
+	return lup.NewPair(a, b), nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *zip_iter[A, B]) Restart() {
+	it.first.Restart()
+	it.second.Restart()
+}
+
+// ZipIter returns an iterator over the pairs of elements of two iterators.
+//
+// Parameters:
+//   - first: The iterator of the first elements.
+//   - second: The iterator of the second elements.
+//
+// Returns:
+//   - common.Iterater[pair.Pair[A, B]]: The zipped iterator.
+//
+// Behaviors:
+//   - If either iterator is nil, an empty iterator is returned.
+//   - The iterator stops as soon as either iterator is exhausted; when first
+//     is longer, one extra element of first is consumed.
+func ZipIter[A, B any](first uc.Iterater[A], second uc.Iterater[B]) uc.Iterater[lup.Pair[A, B]] {
+	if first == nil || second == nil {
+		return uc.NewSimpleIterator[lup.Pair[A, B]](nil)
+	}
+
+	return &zip_iter[A, B]{
+		first:  first,
+		second: second,
+	}
+}
+
+// chain_iter is the iterator returned by ChainIter.
+type chain_iter[T any] struct {
+	// sources are the chained iterators.
+	sources []uc.Iterater[T]
+
+	// idx is the index of the current iterator.
+	idx int
+}
+
+// Consume implements the common.Iterater interface.
+func (it *chain_iter[T]) Consume() (T, error) {
+	for it.idx < len(it.sources) {
+		value, err := it.sources[it.idx].Consume()
+		if err == nil {
+			return value, nil
+		} else if !uc.IsDone(err) {
+			return *new(T), err
+		}
+
+		it.idx++
+	}
+
+	return *new(T), uc.NewErrExhaustedIter()
+}
+
+// Restart implements the common.Iterater interface.
+func (it *chain_iter[T]) Restart() {
+	for _, source := range it.sources {
+		source.Restart()
+	}
+
+	it.idx = 0
+}
+
+// ChainIter returns an iterator over the elements of several iterators, one
+// after the other.
+//
+// Parameters:
+//   - sources: The iterators to chain. Nil iterators are ignored.
+//
+// Returns:
+//   - common.Iterater[T]: The chained iterator.
+func ChainIter[T any](sources ...uc.Iterater[T]) uc.Iterater[T] {
+	chained := make([]uc.Iterater[T], 0, len(sources))
+
+	for _, source := range sources {
+		if source != nil {
+			chained = append(chained, source)
+		}
+	}
+
+	return &chain_iter[T]{
+		sources: chained,
+	}
+}
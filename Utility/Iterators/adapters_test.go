@@ -0,0 +1,54 @@
+package Iterators
+
+import (
+	"slices"
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// collect drains an iterator into a slice.
+func collect[T any](iter uc.Iterater[T]) []T {
+	var values []T
+
+	for {
+		value, err := iter.Consume()
+		if err != nil {
+			return values
+		}
+
+		values = append(values, value)
+	}
+}
+
+func TestAdapters(t *testing.T) {
+	source := uc.NewSimpleIterator([]int{1, 2, 3, 4, 5, 6})
+
+	iter := MapIter(
+		TakeIter(
+			DropIter(FilterIter(source, func(x int) bool { return x%2 == 0 }), 1),
+			5,
+		),
+		func(x int) int { return x * 10 },
+	)
+
+	expected := []int{40, 60}
+
+	if got := collect(iter); !slices.Equal(got, expected) {
+		t.Errorf("adapters failed: expected %v, got %v", expected, got)
+	}
+
+	iter.Restart()
+
+	if got := collect(iter); !slices.Equal(got, expected) {
+		t.Errorf("Restart failed: expected %v, got %v", expected, got)
+	}
+
+	chain := ChainIter(uc.NewSimpleIterator([]int{1}), nil, uc.NewSimpleIterator([]int{2, 3}))
+	zip := ZipIter(chain, uc.NewSimpleIterator([]string{"a", "b"}))
+
+	pairs := collect(zip)
+	if len(pairs) != 2 || pairs[1].First != 2 || pairs[1].Second != "b" {
+		t.Errorf("ZipIter failed: got %v", pairs)
+	}
+}
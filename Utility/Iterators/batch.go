@@ -0,0 +1,183 @@
+package Iterators
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// BatchConsumer is implemented by iterators that can yield several elements
+// in a single call, avoiding the cost of one interface call per element.
+type BatchConsumer[T any] interface {
+	// ConsumeMany consumes up to n elements.
+	//
+	// Parameters:
+	//   - n: The maximum number of elements to consume.
+	//
+	// Returns:
+	//   - []T: The consumed elements. Fewer than n if the iterator ran out.
+	//   - error: An error of type *common.ErrExhaustedIter if no element was
+	//     left, or any other error the iterator failed with.
+	ConsumeMany(n int) ([]T, error)
+
+	uc.Iterater[T]
+}
+
+// ConsumeMany consumes up to n elements from an iterator.
+//
+// Parameters:
+//   - iter: The iterator.
+//   - n: The maximum number of elements to consume.
+//
+// Returns:
+//   - []T: The consumed elements. Fewer than n if the iterator ran out.
+//   - error: An error of type *common.ErrExhaustedIter if no element was left,
+//     or any other error the iterator failed with. In the latter case, the
+//     elements consumed before the error are returned as well.
+//
+// Behaviors:
+//   - If iter implements BatchConsumer, its ConsumeMany method is used.
+//     Otherwise, Consume is called once per element.
+//   - If n is not positive, nothing is consumed and nil is returned.
+func ConsumeMany[T any](iter uc.Iterater[T], n int) ([]T, error) {
+	if iter == nil {
+		return nil, uc.NewErrNilParameter("iter")
+	} else if n <= 0 {
+		return nil, nil
+	}
+
+	bc, ok := iter.(BatchConsumer[T])
+	if ok {
+		return bc.ConsumeMany(n)
+	}
+
+	return consume_each(iter, n)
+}
+
+// consume_each consumes up to n elements, one at a time.
+//
+// Parameters:
+//   - iter: The iterator.
+//   - n: The maximum number of elements to consume. Assumed positive.
+//
+// Returns:
+//   - []T: The consumed elements.
+//   - error: See ConsumeMany.
+func consume_each[T any](iter uc.Iterater[T], n int) ([]T, error) {
+	// The capacity is capped in case n is a "read everything" value.
+	values := make([]T, 0, min(n, 1024))
+
+	for len(values) < n {
+		value, err := iter.Consume()
+		if err == nil {
+			values = append(values, value)
+			continue
+		}
+
+		if uc.IsDone(err) && len(values) > 0 {
+			break
+		}
+
+		return values, err
+	}
+
+	return values, nil
+}
+
+// batch_iter is the iterator returned by Batched.
+type batch_iter[T any] struct {
+	uc.Iterater[T]
+}
+
+// ConsumeMany implements the BatchConsumer interface.
+func (it *batch_iter[T]) ConsumeMany(n int) ([]T, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	return consume_each(it.Iterater, n)
+}
+
+// Batched adapts any iterator into a BatchConsumer.
+//
+// Parameters:
+//   - iter: The iterator.
+//
+// Returns:
+//   - BatchConsumer[T]: The iterator itself if it already is a BatchConsumer,
+//     or an adapter that consumes one element at a time otherwise. Nil if
+//     iter is nil.
+func Batched[T any](iter uc.Iterater[T]) BatchConsumer[T] {
+	if iter == nil {
+		return nil
+	}
+
+	bc, ok := iter.(BatchConsumer[T])
+	if ok {
+		return bc
+	}
+
+	return &batch_iter[T]{
+		Iterater: iter,
+	}
+}
+
+// SliceIterator is an iterator over a slice that supports batch consumption
+// natively.
+type SliceIterator[T any] struct {
+	// values are the values to iterate over.
+	values []T
+
+	// idx is the index of the next value.
+	idx int
+}
+
+// Consume implements the common.Iterater interface.
+func (it *SliceIterator[T]) Consume() (T, error) {
+	if it.idx >= len(it.values) {
+		return *new(T), uc.NewErrExhaustedIter()
+	}
+
+	value := it.values[it.idx]
+	it.idx++
+
+	return value, nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *SliceIterator[T]) Restart() {
+	it.idx = 0
+}
+
+// ConsumeMany implements the BatchConsumer interface.
+//
+// The returned slice is a copy; it can be modified freely.
+func (it *SliceIterator[T]) ConsumeMany(n int) ([]T, error) {
+	if n <= 0 {
+		return nil, nil
+	} else if it.idx >= len(it.values) {
+		return nil, uc.NewErrExhaustedIter()
+	}
+
+	end := min(it.idx+n, len(it.values))
+
+	values := make([]T, end-it.idx)
+	copy(values, it.values[it.idx:end])
+
+	it.idx = end
+
+	return values, nil
+}
+
+// NewSliceIterator creates a new SliceIterator.
+//
+// Parameters:
+//   - values: The values to iterate over. The slice is not copied.
+//
+// Returns:
+//   - *SliceIterator: A pointer to the new SliceIterator.
+func NewSliceIterator[T any](values []T) *SliceIterator[T] {
+	it := &SliceIterator[T]{
+		values: values,
+	}
+
+	return it
+}
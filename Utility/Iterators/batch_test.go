@@ -0,0 +1,89 @@
+package Iterators
+
+import (
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// bench_size is the number of elements drained by the benchmarks.
+const bench_size = 100_000
+
+// bench_values returns the values drained by the benchmarks.
+func bench_values() []int {
+	values := make([]int, bench_size)
+	for i := range values {
+		values[i] = i
+	}
+
+	return values
+}
+
+func TestConsumeMany(t *testing.T) {
+	iters := map[string]uc.Iterater[int]{
+		"SimpleIterator": uc.NewSimpleIterator([]int{1, 2, 3, 4, 5}),
+		"SliceIterator":  NewSliceIterator([]int{1, 2, 3, 4, 5}),
+	}
+
+	for name, iter := range iters {
+		values, err := ConsumeMany(iter, 3)
+		if err != nil || len(values) != 3 {
+			t.Errorf("%s failed: expected 3 values, got %v (%v)", name, values, err)
+		}
+
+		values, err = ConsumeMany(iter, 3)
+		if err != nil || len(values) != 2 {
+			t.Errorf("%s failed: expected 2 values, got %v (%v)", name, values, err)
+		}
+
+		_, err = ConsumeMany(iter, 3)
+		if !uc.IsDone(err) {
+			t.Errorf("%s failed: expected an exhausted iterator, got %v", name, err)
+		}
+	}
+}
+
+func BenchmarkConsume(b *testing.B) {
+	values := bench_values()
+
+	for i := 0; i < b.N; i++ {
+		var iter uc.Iterater[int] = NewSliceIterator(values)
+
+		for {
+			_, err := iter.Consume()
+			if err != nil {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkConsumeManyAdapter(b *testing.B) {
+	values := bench_values()
+
+	for i := 0; i < b.N; i++ {
+		iter := uc.NewSimpleIterator(values)
+
+		for {
+			_, err := ConsumeMany[int](iter, 256)
+			if err != nil {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkConsumeManyNative(b *testing.B) {
+	values := bench_values()
+
+	for i := 0; i < b.N; i++ {
+		iter := NewSliceIterator(values)
+
+		for {
+			_, err := ConsumeMany[int](iter, 256)
+			if err != nil {
+				break
+			}
+		}
+	}
+}
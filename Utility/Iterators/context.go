@@ -0,0 +1,169 @@
+package Iterators
+
+import (
+	"context"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// IteraterCtx is the context-aware variant of common.Iterater. It is meant
+// for iterators over blocking sources (channels, files, delay queues, ...)
+// that must honor cancellation and deadlines.
+type IteraterCtx[T any] interface {
+	// Consume waits for the next element of the iterator.
+	//
+	// Parameters:
+	//   - ctx: The context. Must not be nil.
+	//
+	// Returns:
+	//   - T: The next element.
+	//   - error: An error of type *common.ErrExhaustedIter if there are no
+	//     more elements, ctx.Err() if the context is done before an element
+	//     is available, or any other error the iterator failed with.
+	Consume(ctx context.Context) (T, error)
+
+	// Restart restarts the iterator.
+	Restart()
+}
+
+// ctx_iter is the iterator returned by WithContext.
+type ctx_iter[T any] struct {
+	// source is the wrapped iterator.
+	source uc.Iterater[T]
+}
+
+// Consume implements the IteraterCtx interface.
+func (it *ctx_iter[T]) Consume(ctx context.Context) (T, error) {
+	err := ctx.Err()
+	if err != nil {
+		return *new(T), err
+	}
+
+	return it.source.Consume()
+}
+
+// Restart implements the IteraterCtx interface.
+func (it *ctx_iter[T]) Restart() {
+	it.source.Restart()
+}
+
+// WithContext turns an iterator into a context-aware one.
+//
+// Parameters:
+//   - source: The source iterator.
+//
+// Returns:
+//   - IteraterCtx[T]: The context-aware iterator.
+//
+// Behaviors:
+//   - If source is nil, an empty iterator is returned.
+//   - The context is checked before each call to source.Consume; a call
+//     already in progress is not interrupted.
+func WithContext[T any](source uc.Iterater[T]) IteraterCtx[T] {
+	if source == nil {
+		source = uc.NewSimpleIterator[T](nil)
+	}
+
+	return &ctx_iter[T]{
+		source: source,
+	}
+}
+
+// bound_iter is the iterator returned by BindContext.
+type bound_iter[T any] struct {
+	// source is the context-aware iterator.
+	source IteraterCtx[T]
+
+	// ctx is the context passed to source.
+	ctx context.Context
+}
+
+// Consume implements the common.Iterater interface.
+func (it *bound_iter[T]) Consume() (T, error) {
+	return it.source.Consume(it.ctx)
+}
+
+// Restart implements the common.Iterater interface.
+func (it *bound_iter[T]) Restart() {
+	it.source.Restart()
+}
+
+// BindContext turns a context-aware iterator into a plain one by binding it
+// to a context, so that it can be used with the other adapters.
+//
+// Parameters:
+//   - source: The context-aware iterator.
+//   - ctx: The context passed to every call to source.Consume. If nil,
+//     context.Background() is used.
+//
+// Returns:
+//   - common.Iterater[T]: The plain iterator.
+//
+// Behaviors:
+//   - If source is nil, an empty iterator is returned.
+//   - Once ctx is done, Consume returns ctx.Err(); use errors.Is with
+//     context.Canceled or context.DeadlineExceeded to tell it apart from
+//     exhaustion.
+func BindContext[T any](source IteraterCtx[T], ctx context.Context) uc.Iterater[T] {
+	if source == nil {
+		return uc.NewSimpleIterator[T](nil)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return &bound_iter[T]{
+		source: source,
+		ctx:    ctx,
+	}
+}
+
+// chan_iter is the iterator returned by ChanIter.
+type chan_iter[T any] struct {
+	// ch is the channel the elements are received from.
+	ch <-chan T
+}
+
+// Consume implements the IteraterCtx interface.
+func (it *chan_iter[T]) Consume(ctx context.Context) (T, error) {
+	select {
+	case <-ctx.Done():
+		return *new(T), ctx.Err()
+	case value, ok := <-it.ch:
+		if !ok {
+			return *new(T), uc.NewErrExhaustedIter()
+		}
+
+		return value, nil
+	}
+}
+
+// Restart implements the IteraterCtx interface.
+//
+// Received elements cannot be received again, so this is a no-op.
+func (it *chan_iter[T]) Restart() {}
+
+// ChanIter returns a context-aware iterator over the elements received from
+// a channel.
+//
+// Parameters:
+//   - ch: The channel.
+//
+// Returns:
+//   - IteraterCtx[T]: The iterator.
+//
+// Behaviors:
+//   - Consume blocks until an element is received, the channel is closed,
+//     or the context is done; the iterator is exhausted once the channel is
+//     closed.
+//   - If ch is nil, an empty iterator is returned.
+func ChanIter[T any](ch <-chan T) IteraterCtx[T] {
+	if ch == nil {
+		return WithContext[T](nil)
+	}
+
+	return &chan_iter[T]{
+		ch: ch,
+	}
+}
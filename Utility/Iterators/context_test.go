@@ -0,0 +1,64 @@
+package Iterators
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+func TestContextAdapters(t *testing.T) {
+	iter := BindContext(WithContext(uc.NewSimpleIterator([]int{1, 2, 3})), nil)
+
+	expected := []int{1, 2, 3}
+
+	if got := collect(iter); !slices.Equal(got, expected) {
+		t.Errorf("BindContext failed: expected %v, got %v", expected, got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := WithContext(uc.NewSimpleIterator([]int{1})).Consume(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WithContext failed: expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestChanIter(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+
+	iter := ChanIter(ch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	for _, expected := range []int{1, 2} {
+		value, err := iter.Consume(ctx)
+		if err != nil {
+			t.Fatalf("Consume failed: %s", err.Error())
+		}
+
+		if value != expected {
+			t.Errorf("Consume failed: expected %d, got %d", expected, value)
+		}
+	}
+
+	// The channel is empty but open, so Consume blocks until the deadline.
+	_, err := iter.Consume(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Consume failed: expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	close(ch)
+
+	_, err = iter.Consume(context.Background())
+	if !uc.Is[*uc.ErrExhaustedIter](err) {
+		t.Errorf("Consume failed: expected exhausted iterator, got %v", err)
+	}
+}
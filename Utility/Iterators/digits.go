@@ -0,0 +1,281 @@
+package Iterators
+
+import (
+	"math"
+	"slices"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// DigitOrder is the order in which the digits of a number are yielded.
+type DigitOrder int8
+
+const (
+	// LeastSignificantFirst yields the digits from the least to the most
+	// significant, like MathExt.DecToBase.
+	LeastSignificantFirst DigitOrder = iota
+
+	// MostSignificantFirst yields the digits in the order they are written.
+	MostSignificantFirst
+)
+
+// digit_iter is the iterator returned by DigitIterator.
+type digit_iter struct {
+	// n is the absolute value of the number.
+	n uint
+
+	// base is the base of the digits.
+	base uint
+
+	// order is the order of the digits.
+	order DigitOrder
+
+	// rest is the part of n whose digits are yet to be yielded.
+	rest uint
+
+	// unit is, when the most significant digits come first, the weight of
+	// the next digit. Zero once every digit has been yielded.
+	unit uint
+
+	// count is the number of digits yielded so far.
+	count int
+}
+
+// Consume implements the common.Iterater interface.
+func (it *digit_iter) Consume() (int, error) {
+	if it.base == 1 {
+		// Unary: n zeros, like MathExt.DecToBase.
+		if uint(it.count) == it.n {
+			return 0, uc.NewErrExhaustedIter()
+		}
+
+		it.count++
+
+		return 0, nil
+	}
+
+	if it.order == MostSignificantFirst {
+		if it.unit == 0 {
+			return 0, uc.NewErrExhaustedIter()
+		}
+
+		digit := it.rest / it.unit
+
+		it.rest %= it.unit
+		it.unit /= it.base
+		it.count++
+
+		return int(digit), nil
+	}
+
+	if it.count > 0 && it.rest == 0 {
+		return 0, uc.NewErrExhaustedIter()
+	}
+
+	digit := it.rest % it.base
+
+	it.rest /= it.base
+	it.count++
+
+	return int(digit), nil
+}
+
+// Restart implements the common.Iterater interface.
+func (it *digit_iter) Restart() {
+	it.rest = it.n
+	it.count = 0
+
+	if it.base < 2 || it.order != MostSignificantFirst {
+		return
+	}
+
+	it.unit = 1
+
+	for it.unit <= it.n/it.base {
+		it.unit *= it.base
+	}
+}
+
+// DigitIterator returns an iterator over the digits of a number in a given
+// base. Unlike MathExt.DecToBase, the digits are computed lazily, one per
+// call to Consume, and none is stored.
+//
+// Parameters:
+//   - n: The number. If negative, the digits of its absolute value are
+//     yielded.
+//   - base: The base of the digits.
+//   - order: The order of the digits.
+//
+// Returns:
+//   - common.Iterater[int]: The iterator over the digits.
+//
+// Behaviors:
+//   - If base is less than 1, an empty iterator is returned.
+//   - In base 1, n zeros are yielded, like MathExt.DecToBase.
+//   - Zero has a single digit, 0, in any other base.
+//
+// Example:
+//
+//	iter := DigitIterator(6, 2, MostSignificantFirst)
+//
+//	for {
+//		digit, err := iter.Consume()
+//		if err != nil {
+//			break
+//		}
+//
+//		fmt.Print(digit) // 110
+//	}
+func DigitIterator(n, base int, order DigitOrder) uc.Iterater[int] {
+	if base < 1 {
+		return uc.NewSimpleIterator[int](nil)
+	}
+
+	abs := uint(n)
+	if n < 0 {
+		abs = -abs
+	}
+
+	it := &digit_iter{
+		n:     abs,
+		base:  uint(base),
+		order: order,
+	}
+
+	it.Restart()
+
+	return it
+}
+
+// BaseConverter converts a number from a base to another as it is fed its
+// digits, most significant first. It never holds the number itself, only
+// its digits in the target base; so numbers of any length can be converted,
+// and each digit costs O(d) time, d being the number of digits so far.
+//
+// An empty BaseConverter is not valid; use NewBaseConverter.
+type BaseConverter struct {
+	// from is the base of the digits fed.
+	from int
+
+	// to is the base of the result.
+	to int
+
+	// digits are the digits of the result, least significant first, without
+	// leading zeros.
+	digits []int
+
+	// isFed is true if at least one digit was fed.
+	isFed bool
+}
+
+// Feed feeds the next digit of the number.
+//
+// Parameters:
+//   - digit: The digit, in the source base.
+//
+// Returns:
+//   - error: An error of type *common.ErrInvalidParameter if the digit is
+//     not valid in the source base. The digit is then ignored.
+func (c *BaseConverter) Feed(digit int) error {
+	if digit < 0 || digit >= c.from {
+		return uc.NewErrInvalidParameter(
+			"digit",
+			ers.NewErrOutOfBound(digit, 0, c.from),
+		)
+	}
+
+	c.isFed = true
+
+	carry := digit
+
+	for i, d := range c.digits {
+		value := d*c.from + carry
+
+		c.digits[i] = value % c.to
+		carry = value / c.to
+	}
+
+	for carry > 0 {
+		c.digits = append(c.digits, carry%c.to)
+		carry /= c.to
+	}
+
+	return nil
+}
+
+// Digits returns an iterator over the digits fed so far, in the target base.
+//
+// Parameters:
+//   - order: The order of the digits.
+//
+// Returns:
+//   - common.Iterater[int]: The iterator over the digits. Empty if no digit
+//     was fed.
+//
+// Behaviors:
+//   - The iterator works on a snapshot; digits fed afterwards are not seen.
+func (c *BaseConverter) Digits(order DigitOrder) uc.Iterater[int] {
+	if !c.isFed {
+		return uc.NewSimpleIterator[int](nil)
+	} else if len(c.digits) == 0 {
+		return uc.NewSimpleIterator([]int{0})
+	}
+
+	digits := slices.Clone(c.digits)
+
+	if order == MostSignificantFirst {
+		slices.Reverse(digits)
+	}
+
+	return uc.NewSimpleIterator(digits)
+}
+
+// Reset discards the digits fed so far, to convert another number.
+func (c *BaseConverter) Reset() {
+	c.digits = c.digits[:0]
+	c.isFed = false
+}
+
+// NewBaseConverter creates a new BaseConverter.
+//
+// Parameters:
+//   - from: The base of the digits fed.
+//   - to: The base of the result.
+//
+// Returns:
+//   - *BaseConverter: A pointer to the new BaseConverter.
+//   - error: An error of type *common.ErrInvalidParameter if from or to is
+//     less than 2.
+//
+// Behaviors:
+//   - from * to must fit in an int, or the conversion overflows.
+//
+// Example:
+//
+//	c, _ := NewBaseConverter(10, 2)
+//
+//	_ = c.Feed(1)
+//	_ = c.Feed(2)
+//
+//	iter := c.Digits(MostSignificantFirst) // 1, 1, 0, 0
+func NewBaseConverter(from, to int) (*BaseConverter, error) {
+	if from < 2 {
+		return nil, uc.NewErrInvalidParameter(
+			"from",
+			ers.NewErrOutOfBound(from, 2, math.MaxInt).WithUpperBound(true),
+		)
+	} else if to < 2 {
+		return nil, uc.NewErrInvalidParameter(
+			"to",
+			ers.NewErrOutOfBound(to, 2, math.MaxInt).WithUpperBound(true),
+		)
+	}
+
+	c := &BaseConverter{
+		from: from,
+		to:   to,
+	}
+
+	return c, nil
+}
@@ -0,0 +1,98 @@
+package Iterators
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestDigitIterator(t *testing.T) {
+	tests := []struct {
+		n        int
+		base     int
+		order    DigitOrder
+		expected []int
+	}{
+		{0, 10, LeastSignificantFirst, []int{0}},
+		{0, 10, MostSignificantFirst, []int{0}},
+		{1234, 10, LeastSignificantFirst, []int{4, 3, 2, 1}},
+		{1234, 10, MostSignificantFirst, []int{1, 2, 3, 4}},
+		{-255, 16, MostSignificantFirst, []int{15, 15}},
+		{256, 16, MostSignificantFirst, []int{1, 0, 0}},
+		{3, 1, MostSignificantFirst, []int{0, 0, 0}},
+		{5, 0, MostSignificantFirst, nil},
+		{math.MaxInt, math.MaxInt, MostSignificantFirst, []int{1, 0}},
+	}
+
+	for _, test := range tests {
+		got := collect(DigitIterator(test.n, test.base, test.order))
+
+		if !slices.Equal(got, test.expected) {
+			t.Errorf("DigitIterator(%d, %d) failed: expected %v, got %v", test.n, test.base, test.expected, got)
+		}
+	}
+
+	// The digits of math.MinInt do not fit in an int, but each digit does.
+	got := collect(DigitIterator(math.MinInt, 2, MostSignificantFirst))
+	if len(got) != 64 || got[0] != 1 {
+		t.Errorf("DigitIterator failed: expected 1 followed by 63 zeros, got %v", got)
+	}
+}
+
+func TestBaseConverter(t *testing.T) {
+	c, err := NewBaseConverter(10, 2)
+	if err != nil {
+		t.Fatalf("NewBaseConverter failed: %s", err.Error())
+	}
+
+	if got := collect(c.Digits(MostSignificantFirst)); got != nil {
+		t.Errorf("Digits failed: expected nil, got %v", got)
+	}
+
+	for _, digit := range []int{0, 0} {
+		_ = c.Feed(digit)
+	}
+
+	expected := []int{0}
+
+	if got := collect(c.Digits(MostSignificantFirst)); !slices.Equal(got, expected) {
+		t.Errorf("Digits failed: expected %v, got %v", expected, got)
+	}
+
+	c.Reset()
+
+	for _, digit := range []int{1, 2} {
+		_ = c.Feed(digit)
+	}
+
+	if err := c.Feed(10); err == nil {
+		t.Errorf("Feed failed: expected error, got nil")
+	}
+
+	expected = []int{1, 1, 0, 0}
+
+	if got := collect(c.Digits(MostSignificantFirst)); !slices.Equal(got, expected) {
+		t.Errorf("Digits failed: expected %v, got %v", expected, got)
+	}
+
+	// Numbers longer than an int are converted as well: 2^70 in base 2 to
+	// base 16 is 4 followed by 17 zeros.
+	c, _ = NewBaseConverter(2, 16)
+
+	_ = c.Feed(1)
+	for i := 0; i < 70; i++ {
+		_ = c.Feed(0)
+	}
+
+	got := collect(c.Digits(LeastSignificantFirst))
+	if len(got) != 18 || got[17] != 4 || slices.ContainsFunc(got[:17], func(d int) bool { return d != 0 }) {
+		t.Errorf("Digits failed: expected 4 followed by 17 zeros, got %v", got)
+	}
+
+	for _, bases := range [][2]int{{1, 10}, {10, 1}} {
+		_, err := NewBaseConverter(bases[0], bases[1])
+		if err == nil {
+			t.Errorf("NewBaseConverter(%d, %d) failed: expected error, got nil", bases[0], bases[1])
+		}
+	}
+}
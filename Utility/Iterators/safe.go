@@ -0,0 +1,112 @@
+package Iterators
+
+import (
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// Done is the error returned by SafeIterater once its source is exhausted.
+//
+// It is a sentinel, so errors.Is(err, Done) can be used; it also unwraps to
+// a *common.ErrExhaustedIter, so common.IsDone(Done) is true as well.
+var Done error = &done_error{}
+
+// done_error is the type of Done.
+type done_error struct{}
+
+// Error implements the error interface.
+//
+// Message: "iterator is exhausted"
+func (e *done_error) Error() string {
+	return "iterator is exhausted"
+}
+
+// Unwrap returns a *common.ErrExhaustedIter, so that errors.As and
+// common.IsDone recognize Done.
+//
+// Returns:
+//   - error: The underlying error.
+func (e *done_error) Unwrap() error {
+	return uc.NewErrExhaustedIter()
+}
+
+// SafeIterater wraps an iterator so that it follows the iteration protocol
+// even if the wrapped iterator does not:
+//   - once the source is exhausted, it is never consumed again and every
+//     call to Consume returns Done, until Restart is called;
+//   - a panic in the source is returned as a *common.ErrPanic and ends the
+//     iteration.
+type SafeIterater[T any] struct {
+	// source is the wrapped iterator. Nil if there is none.
+	source uc.Iterater[T]
+
+	// done is true if the source must not be consumed anymore.
+	done bool
+}
+
+// Consume implements the common.Iterater interface.
+//
+// Errors other than exhaustion are returned as is and do not end the
+// iteration.
+func (it *SafeIterater[T]) Consume() (value T, err error) {
+	if it.done {
+		return *new(T), Done
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		it.done = true
+		value, err = *new(T), uc.NewErrPanic(r)
+	}()
+
+	value, err = it.source.Consume()
+	if err == nil {
+		return value, nil
+	}
+
+	if uc.IsDone(err) {
+		it.done = true
+
+		return *new(T), Done
+	}
+
+	return *new(T), err
+}
+
+// Restart implements the common.Iterater interface.
+func (it *SafeIterater[T]) Restart() {
+	if it.source == nil {
+		return
+	}
+
+	it.source.Restart()
+	it.done = false
+}
+
+// NewSafeIterater creates a new SafeIterater.
+//
+// Parameters:
+//   - source: The iterator to wrap.
+//
+// Returns:
+//   - *SafeIterater[T]: A pointer to the new SafeIterater.
+//
+// Behaviors:
+//   - If source is nil, the iterator is empty.
+//   - If source is already a *SafeIterater, it is returned as is.
+func NewSafeIterater[T any](source uc.Iterater[T]) *SafeIterater[T] {
+	safe, ok := source.(*SafeIterater[T])
+	if ok {
+		return safe
+	}
+
+	it := &SafeIterater[T]{
+		source: source,
+		done:   source == nil,
+	}
+
+	return it
+}
@@ -0,0 +1,117 @@
+package Iterators
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// check_protocol checks that an iterator follows the iteration protocol: it
+// yields the expected values, then keeps reporting exhaustion, and yields
+// the same values again after Restart.
+func check_protocol[T comparable](t *testing.T, name string, iter uc.Iterater[T], expected []T) {
+	t.Helper()
+
+	for round := 0; round < 2; round++ {
+		if got := collect(iter); !slices.Equal(got, expected) {
+			t.Errorf("%s failed: expected %v, got %v", name, expected, got)
+		}
+
+		for i := 0; i < 3; i++ {
+			_, err := iter.Consume()
+			if !uc.IsDone(err) {
+				t.Errorf("%s failed: expected exhausted iterator, got %v", name, err)
+			}
+		}
+
+		iter.Restart()
+	}
+}
+
+func TestIterationProtocol(t *testing.T) {
+	values := func() uc.Iterater[int] {
+		return uc.NewSimpleIterator([]int{1, 2, 3, 4})
+	}
+
+	even := func(x int) bool { return x%2 == 0 }
+	double := func(x int) int { return x * 2 }
+
+	check_protocol(t, "MapIter", MapIter(values(), double), []int{2, 4, 6, 8})
+	check_protocol(t, "FilterIter", FilterIter(values(), even), []int{2, 4})
+	check_protocol(t, "TakeIter", TakeIter(values(), 2), []int{1, 2})
+	check_protocol(t, "DropIter", DropIter(values(), 3), []int{4})
+	check_protocol(t, "ChainIter", ChainIter(values(), values()), []int{1, 2, 3, 4, 1, 2, 3, 4})
+	check_protocol(t, "SliceIterator", NewSliceIterator([]int{1, 2}), []int{1, 2})
+	check_protocol(t, "Batched", Batched(values()), []int{1, 2, 3, 4})
+	check_protocol(t, "BindContext", BindContext(WithContext(values()), nil), []int{1, 2, 3, 4})
+	check_protocol(t, "SafeIterater", NewSafeIterater(values()), []int{1, 2, 3, 4})
+	check_protocol(t, "SafeIterater(nil)", NewSafeIterater[int](nil), nil)
+	check_protocol(t, "DigitIterator", DigitIterator(6, 2, MostSignificantFirst), []int{1, 1, 0})
+	check_protocol(t, "DigitIterator(LSD)", DigitIterator(6, 2, LeastSignificantFirst), []int{0, 1, 1})
+
+	c, _ := NewBaseConverter(10, 16)
+	_ = c.Feed(2)
+	_ = c.Feed(5)
+	_ = c.Feed(5)
+
+	check_protocol(t, "BaseConverter", c.Digits(MostSignificantFirst), []int{15, 15})
+}
+
+// fragile_iter is an iterator that panics when consumed past its end.
+type fragile_iter struct {
+	values []int
+	pos    int
+}
+
+func (it *fragile_iter) Consume() (int, error) {
+	if it.pos == len(it.values) {
+		it.pos++
+		return 0, uc.NewErrExhaustedIter()
+	}
+
+	value := it.values[it.pos]
+	it.pos++
+
+	return value, nil
+}
+
+func (it *fragile_iter) Restart() {
+	it.pos = 0
+}
+
+func TestSafeIterater(t *testing.T) {
+	iter := NewSafeIterater[int](&fragile_iter{values: []int{1, 2}})
+
+	check_protocol(t, "SafeIterater", iter, []int{1, 2})
+
+	_, err := iter.Consume()
+	if err != nil {
+		t.Fatalf("Consume failed: %s", err.Error())
+	}
+
+	iter.Consume()
+
+	_, err = iter.Consume()
+	if !errors.Is(err, Done) {
+		t.Errorf("Consume failed: expected %v, got %v", Done, err)
+	}
+
+	if !uc.IsDone(Done) {
+		t.Errorf("IsDone failed: expected true, got false")
+	}
+
+	// A source that panics ends the iteration instead of propagating.
+	panicky := NewSafeIterater[int](&fragile_iter{values: []int{1}, pos: 2})
+
+	_, err = panicky.Consume()
+	if !uc.Is[*uc.ErrPanic](err) {
+		t.Errorf("Consume failed: expected *common.ErrPanic, got %v", err)
+	}
+
+	_, err = panicky.Consume()
+	if !errors.Is(err, Done) {
+		t.Errorf("Consume failed: expected %v, got %v", Done, err)
+	}
+}
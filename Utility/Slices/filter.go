@@ -0,0 +1,78 @@
+package Slices
+
+// FilterIndexed removes, in place, the elements of a slice that do not
+// satisfy a predicate, keeping the order of the other elements.
+//
+// Parameters:
+//   - s: A pointer to the slice.
+//   - pred: The predicate. It is given the index of the element in the
+//     slice as it was before the call.
+//
+// Returns:
+//   - []T: The removed elements, in order. Nil if none was removed.
+//
+// Behaviors:
+//   - If s or pred is nil, nothing is removed.
+//   - pred is called exactly once per element, in order.
+//   - The slots freed at the end of the underlying array are zeroed so that
+//     the removed elements can be garbage collected.
+//
+// Example:
+//
+//	s := []string{"a", "b", "c", "d"}
+//
+//	removed := FilterIndexed(&s, func(i int, _ string) bool { return i%2 == 0 })
+//	fmt.Println(s, removed) // [a c] [b d]
+func FilterIndexed[T any](s *[]T, pred func(i int, v T) bool) []T {
+	if s == nil || pred == nil {
+		return nil
+	}
+
+	slice := *s
+
+	var removed []T
+
+	var top int
+
+	for i, v := range slice {
+		if !pred(i, v) {
+			removed = append(removed, v)
+			continue
+		}
+
+		slice[top] = v
+		top++
+	}
+
+	clear(slice[top:])
+
+	*s = slice[:top]
+
+	return removed
+}
+
+// FilterInPlace removes, in place, the elements of a slice that do not
+// satisfy a predicate. (see FilterIndexed)
+//
+// Parameters:
+//   - s: A pointer to the slice.
+//   - pred: The predicate.
+//
+// Returns:
+//   - []T: The removed elements, in order. Nil if none was removed.
+//
+// Example:
+//
+//	s := []int{1, 2, 3, 4}
+//
+//	removed := FilterInPlace(&s, func(x int) bool { return x > 2 })
+//	fmt.Println(s, removed) // [3 4] [1 2]
+func FilterInPlace[T any](s *[]T, pred func(T) bool) []T {
+	if pred == nil {
+		return nil
+	}
+
+	return FilterIndexed(s, func(_ int, v T) bool {
+		return pred(v)
+	})
+}
@@ -0,0 +1,54 @@
+package Slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	s := []int{5, 1, 4, 2, 3}
+	backing := s
+
+	removed := FilterInPlace(&s, func(x int) bool { return x >= 3 })
+
+	if expected := []int{5, 4, 3}; !slices.Equal(s, expected) {
+		t.Errorf("FilterInPlace failed: expected %v, got %v", expected, s)
+	}
+
+	if expected := []int{1, 2}; !slices.Equal(removed, expected) {
+		t.Errorf("FilterInPlace failed: expected removed %v, got %v", expected, removed)
+	}
+
+	// The freed slots are zeroed.
+	if expected := []int{5, 4, 3, 0, 0}; !slices.Equal(backing, expected) {
+		t.Errorf("FilterInPlace failed: expected backing %v, got %v", expected, backing)
+	}
+
+	removed = FilterInPlace(&s, nil)
+	if removed != nil || len(s) != 3 {
+		t.Errorf("FilterInPlace failed: expected no change, got %v and %v", s, removed)
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	s := []string{"a", "b", "c", "d", "e"}
+
+	var indices []int
+
+	removed := FilterIndexed(&s, func(i int, _ string) bool {
+		indices = append(indices, i)
+		return i%2 == 0
+	})
+
+	if expected := []string{"a", "c", "e"}; !slices.Equal(s, expected) {
+		t.Errorf("FilterIndexed failed: expected %v, got %v", expected, s)
+	}
+
+	if expected := []string{"b", "d"}; !slices.Equal(removed, expected) {
+		t.Errorf("FilterIndexed failed: expected removed %v, got %v", expected, removed)
+	}
+
+	if expected := []int{0, 1, 2, 3, 4}; !slices.Equal(indices, expected) {
+		t.Errorf("FilterIndexed failed: expected indices %v, got %v", expected, indices)
+	}
+}
@@ -0,0 +1,123 @@
+package Slices
+
+// IndexMap matches the elements of a slice against the elements of another.
+//
+// Parameters:
+//   - a: The slice whose elements are matched.
+//   - b: The slice the elements are looked up in.
+//   - eq: The equality function.
+//
+// Returns:
+//   - map[int]int: For each index i of a whose element is in b, the index
+//     of the first element of b equal to a[i]. Never nil.
+//
+// Behaviors:
+//   - The indices of a that are not in the map are the positions of
+//     OrderedDifference(a, b, eq); the ones that are, of
+//     OrderedIntersection(a, b, eq).
+//   - If eq is nil, no element is considered equal to another.
+//   - This takes O(len(a) * len(b)) calls to eq.
+//
+// Example:
+//
+//	a := []string{"x", "y", "z"}
+//	b := []string{"z", "x"}
+//
+//	m := IndexMap(a, b, func(x, y string) bool { return x == y })
+//	fmt.Println(m) // map[0:1 2:0]
+func IndexMap[T any](a, b []T, eq func(T, T) bool) map[int]int {
+	m := make(map[int]int)
+
+	if eq == nil {
+		return m
+	}
+
+	for i, x := range a {
+		for j, y := range b {
+			if eq(x, y) {
+				m[i] = j
+				break
+			}
+		}
+	}
+
+	return m
+}
+
+// OrderedDifference returns the elements of a that are not in b, in the
+// order they appear in a.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice.
+//   - eq: The equality function.
+//
+// Returns:
+//   - []T: The difference. Nil if it is empty.
+//
+// Behaviors:
+//   - Duplicates in a are kept.
+//   - If eq is nil, no element is considered equal to another; so a copy
+//     of a is returned.
+//   - This takes O(len(a) * len(b)) calls to eq.
+//
+// Example:
+//
+//	a := []int{4, 1, 3, 1}
+//	b := []int{3}
+//
+//	diff := OrderedDifference(a, b, func(x, y int) bool { return x == y })
+//	fmt.Println(diff) // [4 1 1]
+func OrderedDifference[T any](a, b []T, eq func(T, T) bool) []T {
+	m := IndexMap(a, b, eq)
+
+	var diff []T
+
+	for i, x := range a {
+		_, ok := m[i]
+		if !ok {
+			diff = append(diff, x)
+		}
+	}
+
+	return diff
+}
+
+// OrderedIntersection returns the elements of a that are also in b, in the
+// order they appear in a.
+//
+// Parameters:
+//   - a: The first slice.
+//   - b: The second slice.
+//   - eq: The equality function.
+//
+// Returns:
+//   - []T: The intersection. Nil if it is empty.
+//
+// Behaviors:
+//   - Duplicates in a are kept.
+//   - If eq is nil, no element is considered equal to another; so nil is
+//     returned.
+//   - This takes O(len(a) * len(b)) calls to eq.
+//
+// Example:
+//
+//	a := []int{4, 1, 3, 1}
+//	b := []int{1, 3}
+//
+//	inter := OrderedIntersection(a, b, func(x, y int) bool { return x == y })
+//	fmt.Println(inter) // [1 3 1]
+func OrderedIntersection[T any](a, b []T, eq func(T, T) bool) []T {
+	m := IndexMap(a, b, eq)
+
+	var inter []T
+
+	for i, x := range a {
+		_, ok := m[i]
+		if ok {
+			inter = append(inter, x)
+		}
+	}
+
+	return inter
+}
@@ -0,0 +1,41 @@
+package Slices
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestOrderedSetOperations(t *testing.T) {
+	a := []string{"b", "A", "c", "a", "d"}
+	b := []string{"D", "a"}
+
+	eq := strings.EqualFold
+
+	expected := []string{"b", "c"}
+
+	if got := OrderedDifference(a, b, eq); !slices.Equal(got, expected) {
+		t.Errorf("OrderedDifference failed: expected %v, got %v", expected, got)
+	}
+
+	expected = []string{"A", "a", "d"}
+
+	if got := OrderedIntersection(a, b, eq); !slices.Equal(got, expected) {
+		t.Errorf("OrderedIntersection failed: expected %v, got %v", expected, got)
+	}
+
+	indices := map[int]int{1: 1, 3: 1, 4: 0}
+
+	if got := IndexMap(a, b, eq); !maps.Equal(got, indices) {
+		t.Errorf("IndexMap failed: expected %v, got %v", indices, got)
+	}
+
+	if got := OrderedDifference(a, b, nil); !slices.Equal(got, a) {
+		t.Errorf("OrderedDifference failed: expected %v, got %v", a, got)
+	}
+
+	if got := OrderedIntersection(a, b, nil); got != nil {
+		t.Errorf("OrderedIntersection failed: expected nil, got %v", got)
+	}
+}
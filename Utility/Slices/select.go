@@ -0,0 +1,179 @@
+package Slices
+
+import (
+	"container/heap"
+	"context"
+	"math"
+
+	uc "github.com/PlayerR9/lib_units/common"
+	luh "github.com/PlayerR9/lib_units/helpers"
+)
+
+// SelectOptions are the options of SelectBestK.
+type SelectOptions struct {
+	// Minimize is true if the lowest weights are the best, false if the
+	// highest are.
+	Minimize bool
+
+	// IsPerfect checks whether a weight is a perfect score; that is, no
+	// other weight can be better. Once k elements with a perfect score are
+	// found, the remaining elements are not evaluated. If nil, every
+	// element is evaluated.
+	IsPerfect func(weight float64) bool
+}
+
+// select_item is an element being selected by SelectBestK.
+type select_item[T any] struct {
+	// elem is the element.
+	elem T
+
+	// weight is the weight of the element.
+	weight float64
+
+	// score is the weight, negated if the lowest weights are the best; so
+	// the highest score is always the best.
+	score float64
+
+	// index is the index of the element in the input.
+	index int
+}
+
+// select_heap is a heap of the best elements found so far, with the worst
+// of them on top.
+type select_heap[T any] []*select_item[T]
+
+// Len implements the heap.Interface interface.
+func (h select_heap[T]) Len() int {
+	return len(h)
+}
+
+// Less implements the heap.Interface interface.
+//
+// An element is less than another if it is worse; of two elements with the
+// same score, the one that comes later in the input is worse.
+func (h select_heap[T]) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score < h[j].score
+	}
+
+	return h[i].index > h[j].index
+}
+
+// Swap implements the heap.Interface interface.
+func (h select_heap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push implements the heap.Interface interface.
+func (h *select_heap[T]) Push(x any) {
+	*h = append(*h, x.(*select_item[T]))
+}
+
+// Pop implements the heap.Interface interface.
+func (h *select_heap[T]) Pop() any {
+	old := *h
+	n := len(old)
+
+	item := old[n-1]
+	old[n-1] = nil // Help the GC.
+
+	*h = old[:n-1]
+
+	return item
+}
+
+// SelectBestK returns the k elements with the best weights, without sorting
+// all of them; it takes O(n log k) time and O(k) memory.
+//
+// Parameters:
+//   - ctx: The context. Must not be nil. It is checked before evaluating
+//     each element.
+//   - items: The elements.
+//   - weightFn: The weight function. Elements whose weight is not valid, or
+//     is NaN, are skipped.
+//   - k: The maximum number of elements to return.
+//   - opts: The options.
+//
+// Returns:
+//   - []*helpers.WeightedElement[T]: The best elements, from the best to the
+//     worst. Of elements with the same weight, the first in items comes
+//     first. Nil if k is less than 1 or no weight is valid.
+//   - error: An error of type *common.ErrInvalidParameter if weightFn is
+//     nil, or ctx.Err() if the context is done before all the elements are
+//     evaluated; in that case, the best elements found so far are returned
+//     as well.
+//
+// Example:
+//
+//	words := []string{"go", "gopher", "golang", "g"}
+//
+//	best, _ := SelectBestK(context.Background(), words, func(s string) (float64, bool) {
+//		return float64(len(s)), true
+//	}, 2, SelectOptions{})
+//
+//	for _, we := range best {
+//		w, _ := we.Data()
+//		fmt.Println(w) // gopher, golang
+//	}
+func SelectBestK[T any](ctx context.Context, items []T, weightFn luh.WeightFunc[T], k int, opts SelectOptions) ([]*luh.WeightedElement[T], error) {
+	if weightFn == nil {
+		return nil, uc.NewErrNilParameter("weightFn")
+	} else if k < 1 {
+		return nil, nil
+	}
+
+	h := make(select_heap[T], 0, min(k, len(items)))
+
+	var err error
+
+	for i, elem := range items {
+		err = ctx.Err()
+		if err != nil {
+			break
+		}
+
+		weight, ok := weightFn(elem)
+		if !ok || math.IsNaN(weight) {
+			continue
+		}
+
+		score := weight
+		if opts.Minimize {
+			score = -weight
+		}
+
+		item := &select_item[T]{
+			elem:   elem,
+			weight: weight,
+			score:  score,
+			index:  i,
+		}
+
+		if len(h) < k {
+			heap.Push(&h, item)
+		} else if item.score > h[0].score {
+			h[0] = item
+			heap.Fix(&h, 0)
+		} else {
+			continue
+		}
+
+		if len(h) == k && opts.IsPerfect != nil && opts.IsPerfect(h[0].weight) {
+			break
+		}
+	}
+
+	if len(h) == 0 {
+		return nil, err
+	}
+
+	best := make([]*luh.WeightedElement[T], len(h))
+
+	for i := len(h) - 1; i >= 0; i-- {
+		item := heap.Pop(&h).(*select_item[T])
+
+		best[i] = luh.NewWeightedElement(item.elem, item.weight)
+	}
+
+	return best, err
+}
@@ -0,0 +1,127 @@
+package Slices
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	luh "github.com/PlayerR9/lib_units/helpers"
+)
+
+// elems_of returns the elements of weighted elements.
+func elems_of[T any](weighted []*luh.WeightedElement[T]) []T {
+	elems := make([]T, 0, len(weighted))
+
+	for _, we := range weighted {
+		elem, _ := we.Data()
+		elems = append(elems, elem)
+	}
+
+	return elems
+}
+
+func TestSelectBestK(t *testing.T) {
+	words := []string{"go", "gopher", "", "golang", "g", "gophers", "ab"}
+
+	length := func(s string) (float64, bool) {
+		return float64(len(s)), s != ""
+	}
+
+	tests := []struct {
+		name     string
+		k        int
+		opts     SelectOptions
+		expected []string
+	}{
+		{"highest", 3, SelectOptions{}, []string{"gophers", "gopher", "golang"}},
+		{"lowest", 3, SelectOptions{Minimize: true}, []string{"g", "go", "ab"}},
+		{"more than items", 10, SelectOptions{}, []string{"gophers", "gopher", "golang", "go", "ab", "g"}},
+	}
+
+	for _, test := range tests {
+		best, err := SelectBestK(context.Background(), words, length, test.k, test.opts)
+		if err != nil {
+			t.Fatalf("SelectBestK failed (%s): %s", test.name, err.Error())
+		}
+
+		if got := elems_of(best); !slices.Equal(got, test.expected) {
+			t.Errorf("SelectBestK failed (%s): expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+
+	best, err := SelectBestK(context.Background(), words, length, 0, SelectOptions{})
+	if err != nil || best != nil {
+		t.Errorf("SelectBestK failed: expected nil, got %v, %v", best, err)
+	}
+
+	_, err = SelectBestK[string](context.Background(), words, nil, 1, SelectOptions{})
+	if err == nil {
+		t.Errorf("SelectBestK failed: expected error, got nil")
+	}
+}
+
+func TestSelectBestKPerfect(t *testing.T) {
+	items := []int{3, 0, 5, 0, 7, 1}
+
+	var calls int
+
+	weight := func(x int) (float64, bool) {
+		calls++
+		return float64(x), true
+	}
+
+	opts := SelectOptions{
+		Minimize: true,
+		IsPerfect: func(w float64) bool {
+			return w == 0
+		},
+	}
+
+	best, err := SelectBestK(context.Background(), items, weight, 2, opts)
+	if err != nil {
+		t.Fatalf("SelectBestK failed: %s", err.Error())
+	}
+
+	expected := []int{0, 0}
+
+	if got := elems_of(best); !slices.Equal(got, expected) {
+		t.Errorf("SelectBestK failed: expected %v, got %v", expected, got)
+	}
+
+	if calls != 4 {
+		t.Errorf("SelectBestK failed: expected %d calls, got %d", 4, calls)
+	}
+}
+
+func TestSelectBestKCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int
+
+	weight := func(x int) (float64, bool) {
+		calls++
+
+		if calls == 3 {
+			cancel()
+		}
+
+		return float64(x), true
+	}
+
+	best, err := SelectBestK(ctx, []int{1, 4, 2, 8, 9}, weight, 2, SelectOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("SelectBestK failed: expected %v, got %v", context.Canceled, err)
+	}
+
+	expected := []int{4, 2}
+
+	if got := elems_of(best); !slices.Equal(got, expected) {
+		t.Errorf("SelectBestK failed: expected %v, got %v", expected, got)
+	}
+
+	if calls != 3 {
+		t.Errorf("SelectBestK failed: expected %d calls, got %d", 3, calls)
+	}
+}
@@ -0,0 +1,47 @@
+package Slices
+
+import (
+	gen "github.com/PlayerR9/MyGoLib/Utility/General"
+)
+
+// Unique returns the elements of a slice without duplicates, in the order
+// they first appear. Elements are compared with General.EqualsOf; so it
+// works with elements that are not comparable and with elements that
+// implement General.Equaler.
+//
+// Parameters:
+//   - s: The slice.
+//
+// Returns:
+//   - []T: The unique elements. Nil if s is empty.
+//
+// Behaviors:
+//   - The first of the equal elements is kept.
+//   - This takes O(n^2) comparisons. For comparable elements, prefer a map.
+//
+// Example:
+//
+//	s := [][]int{{1, 2}, {3}, {1, 2}}
+//
+//	unique := Unique(s)
+//	fmt.Println(unique) // [[1 2] [3]]
+func Unique[T any](s []T) []T {
+	var unique []T
+
+	for _, x := range s {
+		var found bool
+
+		for _, y := range unique {
+			if gen.EqualsOf(y, x) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			unique = append(unique, x)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,20 @@
+package Slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	s := [][]int{{1, 2}, {3}, {1, 2}, nil, {3}, nil}
+
+	expected := [][]int{{1, 2}, {3}, nil}
+
+	if got := Unique(s); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unique failed: expected %v, got %v", expected, got)
+	}
+
+	if got := Unique[int](nil); got != nil {
+		t.Errorf("Unique failed: expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,166 @@
+package Sorting
+
+import (
+	"math"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+// ApproxEqual checks whether two floats are equal within a tolerance.
+//
+// Parameters:
+//   - a: The first float.
+//   - b: The second float.
+//   - epsilon: The tolerance. Negative values are treated as 0.
+//
+// Returns:
+//   - bool: True if the floats are approximately equal, false otherwise.
+//
+// Behaviors:
+//   - The floats are equal if their difference is at most epsilon, or at
+//     most epsilon times the largest of their magnitudes; so that epsilon
+//     works both near zero and for large values.
+//   - NaN is never equal to anything, including NaN.
+//   - Infinities are only equal to infinities of the same sign.
+func ApproxEqual(a, b, epsilon float64) bool {
+	if a == b {
+		return true
+	} else if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+
+	if epsilon < 0 {
+		epsilon = 0
+	}
+
+	diff := math.Abs(a - b)
+	if diff <= epsilon {
+		return true
+	}
+
+	largest := math.Max(math.Abs(a), math.Abs(b))
+
+	return diff <= epsilon*largest
+}
+
+// CompareWithTolerance compares two floats, considering them equal if they
+// are within a tolerance. (see ApproxEqual)
+//
+// Parameters:
+//   - a: The first float.
+//   - b: The second float.
+//   - epsilon: The tolerance.
+//
+// Returns:
+//   - int: A negative value if a < b, 0 if a and b are approximately equal,
+//     and a positive value if a > b.
+//
+// Behaviors:
+//   - As with cmp.Compare, NaN is less than any other value and equal to
+//     NaN.
+//   - The tolerance makes the comparison non-transitive: a can be equal to
+//     b and b to c while a < c. So it is not a valid ordering for sorting
+//     or binary searching; use cmp.Compare for those and this function only
+//     to check whether two values are equal.
+func CompareWithTolerance(a, b, epsilon float64) int {
+	aNaN, bNaN := math.IsNaN(a), math.IsNaN(b)
+
+	if aNaN {
+		if bNaN {
+			return 0
+		}
+
+		return -1
+	} else if bNaN {
+		return 1
+	}
+
+	if ApproxEqual(a, b, epsilon) {
+		return 0
+	} else if a < b {
+		return -1
+	} else {
+		return 1
+	}
+}
+
+// Float64Comparer returns a function that checks whether two floats are
+// equal within a tolerance. (see CompareWithTolerance)
+//
+// Parameters:
+//   - epsilon: The tolerance.
+//
+// Returns:
+//   - func(a, b float64) bool: The equality function.
+//
+// Behaviors:
+//   - Unlike ApproxEqual, NaN is equal to NaN; so the function can be used
+//     to find or remove duplicates. (e.g., with slices.CompactFunc)
+//   - This is not a SortFunc on purpose: a tolerance makes the comparison
+//     non-transitive, so it cannot order values. Use cmp.Compare to sort
+//     floats.
+//
+// Example:
+//
+//	equal := Float64Comparer(1e-9)
+//	fmt.Println(equal(0.1+0.2, 0.3)) // true
+func Float64Comparer(epsilon float64) func(a, b float64) bool {
+	return func(a, b float64) bool {
+		return CompareWithTolerance(a, b, epsilon) == 0
+	}
+}
+
+// MinFloat64 returns the smallest of the given floats, ignoring NaNs.
+//
+// Parameters:
+//   - values: The floats.
+//
+// Returns:
+//   - float64: The smallest float.
+//   - error: ers.ErrEmptyInput if there are no values other than NaNs.
+func MinFloat64(values ...float64) (float64, error) {
+	return extremum(values, func(a, b float64) bool { return a < b })
+}
+
+// MaxFloat64 returns the largest of the given floats, ignoring NaNs.
+//
+// Parameters:
+//   - values: The floats.
+//
+// Returns:
+//   - float64: The largest float.
+//   - error: ers.ErrEmptyInput if there are no values other than NaNs.
+func MaxFloat64(values ...float64) (float64, error) {
+	return extremum(values, func(a, b float64) bool { return a > b })
+}
+
+// extremum returns the best of the given floats, ignoring NaNs.
+//
+// Parameters:
+//   - values: The floats.
+//   - better: A function that checks whether a is better than b.
+//
+// Returns:
+//   - float64: The best float.
+//   - error: ers.ErrEmptyInput if there are no values other than NaNs.
+func extremum(values []float64, better func(a, b float64) bool) (float64, error) {
+	var best float64
+	found := false
+
+	for _, value := range values {
+		if math.IsNaN(value) {
+			continue
+		}
+
+		if !found || better(value, best) {
+			best = value
+			found = true
+		}
+	}
+
+	if !found {
+		return 0, ers.ErrEmptyInput
+	}
+
+	return best, nil
+}
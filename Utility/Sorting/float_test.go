@@ -0,0 +1,112 @@
+package Sorting
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	ers "github.com/PlayerR9/MyGoLib/Utility/errors"
+)
+
+func TestApproxEqual(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		a, b     float64
+		expected bool
+	}{
+		{0.1 + 0.2, 0.3, true},
+		{1e12, 1e12 + 1, true},
+		{1, 1.1, false},
+		{nan, nan, false},
+		{nan, 1, false},
+		{inf, inf, true},
+		{-inf, -inf, true},
+		{inf, -inf, false},
+		{inf, math.MaxFloat64, false},
+	}
+
+	for _, test := range tests {
+		if got := ApproxEqual(test.a, test.b, 1e-9); got != test.expected {
+			t.Errorf("ApproxEqual(%v, %v) failed: expected %t, got %t", test.a, test.b, test.expected, got)
+		}
+	}
+}
+
+func TestCompareWithTolerance(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		a, b     float64
+		expected int
+	}{
+		{nan, nan, 0},
+		{nan, -inf, -1},
+		{-inf, nan, 1},
+		{inf, inf, 0},
+		{-inf, inf, -1},
+		{inf, 1, 1},
+		{0.1 + 0.2, 0.3, 0},
+		{1, 2, -1},
+	}
+
+	for _, test := range tests {
+		if got := CompareWithTolerance(test.a, test.b, 1e-9); got != test.expected {
+			t.Errorf("CompareWithTolerance(%v, %v) failed: expected %d, got %d", test.a, test.b, test.expected, got)
+		}
+	}
+}
+
+func TestFloat64Comparer(t *testing.T) {
+	nan := math.NaN()
+
+	equal := Float64Comparer(1e-9)
+
+	tests := []struct {
+		a, b     float64
+		expected bool
+	}{
+		{0.1 + 0.2, 0.3, true},
+		{nan, nan, true},
+		{nan, 0, false},
+		{1, 2, false},
+	}
+
+	for _, test := range tests {
+		if got := equal(test.a, test.b); got != test.expected {
+			t.Errorf("Float64Comparer(%v, %v) failed: expected %t, got %t", test.a, test.b, test.expected, got)
+		}
+	}
+
+	values := []float64{0.3, 0.1 + 0.2, nan, nan, 1}
+	values = slices.CompactFunc(values, equal)
+
+	if len(values) != 3 {
+		t.Errorf("Float64Comparer failed: expected %d values once compacted, got %v", 3, values)
+	}
+}
+
+func TestMinMaxFloat64(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	low, err := MinFloat64(nan, 3, -inf, 1)
+	if err != nil || !math.IsInf(low, -1) {
+		t.Errorf("MinFloat64 failed: expected %v, got %v (%v)", -inf, low, err)
+	}
+
+	high, err := MaxFloat64(nan, 3, inf, nan)
+	if err != nil || !math.IsInf(high, 1) {
+		t.Errorf("MaxFloat64 failed: expected %v, got %v (%v)", inf, high, err)
+	}
+
+	_, err = MinFloat64(nan, nan)
+	if !errors.Is(err, ers.ErrEmptyInput) {
+		t.Errorf("MinFloat64 failed: expected %v, got %v", ers.ErrEmptyInput, err)
+	}
+
+	_, err = MaxFloat64()
+	if !errors.Is(err, ers.ErrEmptyInput) {
+		t.Errorf("MaxFloat64 failed: expected %v, got %v", ers.ErrEmptyInput, err)
+	}
+}
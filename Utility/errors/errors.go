@@ -3,7 +3,6 @@ package errors
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	utstr "github.com/PlayerR9/lib_units/strings"
 )
@@ -22,11 +21,13 @@ type ErrUnexpected struct {
 //
 // Message: "expected <value 0>, <value 1>, <value 2>, ..., or <value n>,
 // got <actual> instead"
+//
+// The message can be changed with SetMessage(KeyUnexpected, ...).
 func (e *ErrUnexpected) Error() string {
 	var expected string
 
 	if len(e.Expected) == 0 {
-		expected = "nothing"
+		expected = FormatMessage(KeyNothing)
 	} else {
 		expected = utstr.EitherOrString(e.Expected, true)
 	}
@@ -34,22 +35,20 @@ func (e *ErrUnexpected) Error() string {
 	var actual string
 
 	if e.Actual == "" {
-		actual = "nothing"
+		actual = FormatMessage(KeyNothing)
 	} else {
 		actual = strconv.Quote(e.Actual)
 	}
 
-	values := []string{
-		"expected",
-		expected,
-		", got",
-		actual,
-		"instead",
-	}
-
-	str := strings.Join(values, " ")
+	return FormatMessage(KeyUnexpected, "expected", expected, "actual", actual)
+}
 
-	return str
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - MessageKey: The key of the message.
+func (e *ErrUnexpected) MessageKey() MessageKey {
+	return KeyUnexpected
 }
 
 // NewErrUnexpected creates a new ErrUnexpected error.
@@ -80,9 +79,12 @@ type ErrInvalidValues[T comparable] struct {
 // Message: "value must not be <value 0>, <value 1>, <value 2>, ..., or <value n>"
 //
 // If no values are provided, the message is "value is invalid".
+//
+// The messages can be changed with SetMessage(KeyInvalidValues, ...) and
+// SetMessage(KeyInvalidValue, ...).
 func (e *ErrInvalidValues[T]) Error() string {
 	if len(e.Values) == 0 {
-		return "value is invalid"
+		return FormatMessage(KeyInvalidValue)
 	}
 
 	values := make([]string, 0, len(e.Values))
@@ -92,13 +94,19 @@ func (e *ErrInvalidValues[T]) Error() string {
 
 	value := utstr.OrString(values, false, true)
 
-	var builder strings.Builder
+	return FormatMessage(KeyInvalidValues, "values", value)
+}
 
-	builder.WriteString("value must not be ")
-	builder.WriteString(value)
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - MessageKey: The key of the message.
+func (e *ErrInvalidValues[T]) MessageKey() MessageKey {
+	if len(e.Values) == 0 {
+		return KeyInvalidValue
+	}
 
-	str := builder.String()
-	return str
+	return KeyInvalidValues
 }
 
 // NewErrInvalidValues creates a new ErrInvalidValues error.
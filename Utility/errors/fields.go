@@ -0,0 +1,191 @@
+package errors
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrWithFields is an error that carries structured context (e.g., the
+// input index, the file name, or the command) as key/value fields.
+//
+// The fields do not change the message of the error; use Fields to
+// retrieve them and FormatFields to render them.
+type ErrWithFields struct {
+	// Reason is the error the fields are attached to.
+	Reason error
+
+	// fields are the attached fields.
+	fields map[string]any
+}
+
+// Error implements the error interface.
+//
+// Message: the message of the reason.
+func (e *ErrWithFields) Error() string {
+	if e.Reason == nil {
+		return "something went wrong"
+	}
+
+	return e.Reason.Error()
+}
+
+// Unwrap implements the common.Unwrapper interface.
+func (e *ErrWithFields) Unwrap() error {
+	return e.Reason
+}
+
+// ChangeReason implements the common.Unwrapper interface.
+func (e *ErrWithFields) ChangeReason(reason error) {
+	e.Reason = reason
+}
+
+// WithField attaches a key/value field to an error.
+//
+// Parameters:
+//   - err: The error to attach the field to.
+//   - key: The key of the field.
+//   - value: The value of the field.
+//
+// Returns:
+//   - error: The error with the field attached. Nil if err is nil.
+//
+// Behaviors:
+//   - If err already is an *ErrWithFields, a copy of it with the new field
+//     is returned; err itself is not modified.
+//   - Fields survive wrapping with fmt.Errorf("%w") or any other error
+//     that implements Unwrap.
+func WithField(err error, key string, value any) error {
+	if err == nil {
+		return nil
+	}
+
+	ef, ok := err.(*ErrWithFields)
+	if !ok {
+		ef = &ErrWithFields{
+			Reason: err,
+		}
+	}
+
+	fields := make(map[string]any, len(ef.fields)+1)
+	for k, v := range ef.fields {
+		fields[k] = v
+	}
+
+	fields[key] = value
+
+	e := &ErrWithFields{
+		Reason: ef.Reason,
+		fields: fields,
+	}
+
+	return e
+}
+
+// Fields returns all the fields attached to an error or to any error it
+// wraps.
+//
+// Parameters:
+//   - err: The error.
+//
+// Returns:
+//   - map[string]any: The fields. Never nil.
+//
+// Behaviors:
+//   - Both kinds of wrapping are followed: Unwrap() error (e.g.,
+//     fmt.Errorf with one %w) and Unwrap() []error (e.g., errors.Join, or
+//     fmt.Errorf with several %w).
+//   - When the same key is attached at several places, the first value
+//     found in a depth-first walk wins; that is, outer errors take
+//     precedence over the errors they wrap, and earlier joined errors over
+//     later ones.
+func Fields(err error) map[string]any {
+	fields := make(map[string]any)
+
+	collect_fields(err, fields)
+
+	return fields
+}
+
+// collect_fields adds the fields of an error and of the errors it wraps,
+// depth-first, to a map. Keys already in the map are kept.
+//
+// Parameters:
+//   - err: The error.
+//   - fields: The map to add the fields to.
+func collect_fields(err error, fields map[string]any) {
+	for err != nil {
+		ef, ok := err.(*ErrWithFields)
+		if ok {
+			for k, v := range ef.fields {
+				_, exists := fields[k]
+				if !exists {
+					fields[k] = v
+				}
+			}
+		}
+
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, inner := range x.Unwrap() {
+				collect_fields(inner, fields)
+			}
+
+			return
+		default:
+			return
+		}
+	}
+}
+
+// FormatFields renders the fields of an error as a block of lines, sorted
+// by key, with the values aligned.
+//
+// Parameters:
+//   - err: The error.
+//
+// Returns:
+//   - string: The rendered fields. Empty if the error has no fields.
+//
+// Example:
+//
+//	err := WithField(WithField(e, "file", "main.go"), "index", 3)
+//	fmt.Println(FormatFields(err))
+//	// Output:
+//	// file : main.go
+//	// index: 3
+func FormatFields(err error) string {
+	fields := Fields(err)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(fields))
+	width := 0
+
+	for k := range fields {
+		keys = append(keys, k)
+
+		w := utf8.RuneCountInString(k)
+		if w > width {
+			width = w
+		}
+	}
+
+	slices.Sort(keys)
+
+	lines := make([]string, 0, len(keys))
+
+	for _, k := range keys {
+		padding := strings.Repeat(" ", width-utf8.RuneCountInString(k))
+
+		lines = append(lines, fmt.Sprintf("%s%s: %v", k, padding, fields[k]))
+	}
+
+	str := strings.Join(lines, "\n")
+
+	return str
+}
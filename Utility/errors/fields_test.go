@@ -0,0 +1,76 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+)
+
+func TestFieldsWrapped(t *testing.T) {
+	base := errors.New("boom")
+
+	err := WithField(base, "index", 3)
+	err = fmt.Errorf("parsing: %w", err)
+	err = WithField(err, "file", "main.go")
+
+	expected := map[string]any{"index": 3, "file": "main.go"}
+
+	if got := Fields(err); !maps.Equal(got, expected) {
+		t.Errorf("Fields failed: expected %v, got %v", expected, got)
+	}
+
+	if !errors.Is(err, base) {
+		t.Errorf("WithField failed: expected the reason to be kept")
+	}
+
+	if got := err.Error(); got != "parsing: boom" {
+		t.Errorf("WithField failed: expected %q, got %q", "parsing: boom", got)
+	}
+
+	// The outer value wins.
+	err = WithField(fmt.Errorf("again: %w", err), "index", 4)
+
+	if got := Fields(err)["index"]; got != 4 {
+		t.Errorf("Fields failed: expected %v, got %v", 4, got)
+	}
+}
+
+func TestFieldsJoined(t *testing.T) {
+	a := WithField(errors.New("a"), "index", 1)
+	b := WithField(WithField(errors.New("b"), "index", 2), "file", "b.go")
+
+	err := errors.Join(a, fmt.Errorf("wrapped: %w", b))
+
+	expected := map[string]any{"index": 1, "file": "b.go"}
+
+	if got := Fields(err); !maps.Equal(got, expected) {
+		t.Errorf("Fields failed: expected %v, got %v", expected, got)
+	}
+
+	err = fmt.Errorf("%w and %w", b, a)
+
+	expected = map[string]any{"index": 2, "file": "b.go"}
+
+	if got := Fields(err); !maps.Equal(got, expected) {
+		t.Errorf("Fields failed: expected %v, got %v", expected, got)
+	}
+
+	if got := Fields(nil); len(got) != 0 {
+		t.Errorf("Fields failed: expected no fields, got %v", got)
+	}
+}
+
+func TestFormatFields(t *testing.T) {
+	err := WithField(WithField(errors.New("boom"), "file", "main.go"), "index", 3)
+
+	expected := "file : main.go\nindex: 3"
+
+	if got := FormatFields(err); got != expected {
+		t.Errorf("FormatFields failed: expected %q, got %q", expected, got)
+	}
+
+	if got := FormatFields(errors.New("plain")); got != "" {
+		t.Errorf("FormatFields failed: expected %q, got %q", "", got)
+	}
+}
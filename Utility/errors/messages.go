@@ -0,0 +1,205 @@
+package errors
+
+import (
+	"maps"
+	"strings"
+	"sync"
+)
+
+// MessageKey is the key that identifies the message template of an error.
+type MessageKey string
+
+const (
+	// KeyUnexpected is the key of the ErrUnexpected message.
+	//
+	// Parameters: "expected", "actual".
+	KeyUnexpected MessageKey = "unexpected"
+
+	// KeyInvalidValues is the key of the ErrInvalidValues message.
+	//
+	// Parameters: "values".
+	KeyInvalidValues MessageKey = "invalid_values"
+
+	// KeyInvalidValue is the key of the ErrInvalidValues message when no
+	// values are given.
+	KeyInvalidValue MessageKey = "invalid_value"
+
+	// KeyNothing is the key of the word used when a list of values is empty.
+	KeyNothing MessageKey = "nothing"
+
+	// KeyNotFound is the key of the ErrNotFound message.
+	KeyNotFound MessageKey = "not_found"
+
+	// KeyFull is the key of the ErrFull message.
+	KeyFull MessageKey = "full"
+
+	// KeyEmptyInput is the key of the ErrEmptyInput message.
+	KeyEmptyInput MessageKey = "empty_input"
+
+	// KeyTooLong is the key of the ErrTooLong message.
+	KeyTooLong MessageKey = "too_long"
+
+	// KeyOutOfRange is the key of the ErrOutOfRange message.
+	KeyOutOfRange MessageKey = "out_of_range"
+
+	// KeyOutOfBound is the key of the ErrOutOfBound message.
+	//
+	// Parameters: "value", "range".
+	KeyOutOfBound MessageKey = "out_of_bound"
+
+	// KeyFileNotOpen is the key of the FileManager.ErrFileNotOpen message.
+	KeyFileNotOpen MessageKey = "file_not_open"
+
+	// KeyPathNot is the key of the FileManager.ErrPathNot message.
+	//
+	// Parameters: "path", "expected".
+	KeyPathNot MessageKey = "path_not"
+
+	// KeyKeyNotFound is the key of the OrderedMap.ErrKeyNotFound message.
+	KeyKeyNotFound MessageKey = "key_not_found"
+)
+
+var (
+	// default_messages is the default English catalog of message templates.
+	// It is never modified after init, so it can be read without locking.
+	default_messages map[MessageKey]string
+
+	// overrides are the templates that replace the default ones.
+	overrides map[MessageKey]string
+
+	// overrides_mu is the mutex that protects overrides.
+	overrides_mu sync.RWMutex
+)
+
+func init() {
+	default_messages = map[MessageKey]string{
+		KeyUnexpected:    "expected {expected}, got {actual} instead",
+		KeyInvalidValues: "value must not be {values}",
+		KeyInvalidValue:  "value is invalid",
+		KeyNothing:       "nothing",
+		KeyNotFound:      "not found",
+		KeyFull:          "container is full",
+		KeyEmptyInput:    "input is empty",
+		KeyTooLong:       "input is too long",
+		KeyOutOfRange:    "value is out of range",
+		KeyOutOfBound:    "value {value} is out of bounds {range}",
+		KeyFileNotOpen:   "file was not opened",
+		KeyPathNot:       "path {path} is not {expected}",
+		KeyKeyNotFound:   "key not found",
+	}
+
+	overrides = make(map[MessageKey]string)
+}
+
+// DefaultMessages returns the default English catalog of message templates.
+//
+// Placeholders are written as "{name}" and replaced by the parameter of the
+// same name.
+//
+// Returns:
+//   - map[MessageKey]string: A copy of the catalog. Modifying it has no
+//     effect; use SetMessage or SetMessages to change a message.
+func DefaultMessages() map[MessageKey]string {
+	return maps.Clone(default_messages)
+}
+
+// SetMessage overrides the template of a message. Applications use this to
+// translate or rephrase the messages of this package.
+//
+// Parameters:
+//   - key: The key of the message.
+//   - template: The new template. An empty template restores the default one.
+func SetMessage(key MessageKey, template string) {
+	overrides_mu.Lock()
+	defer overrides_mu.Unlock()
+
+	if template == "" {
+		delete(overrides, key)
+	} else {
+		overrides[key] = template
+	}
+}
+
+// SetMessages overrides the templates of several messages at once.
+//
+// Parameters:
+//   - catalog: The templates to use, keyed by message key.
+func SetMessages(catalog map[MessageKey]string) {
+	overrides_mu.Lock()
+	defer overrides_mu.Unlock()
+
+	for key, template := range catalog {
+		if template == "" {
+			delete(overrides, key)
+		} else {
+			overrides[key] = template
+		}
+	}
+}
+
+// ResetMessages restores all the default templates.
+func ResetMessages() {
+	overrides_mu.Lock()
+	defer overrides_mu.Unlock()
+
+	overrides = make(map[MessageKey]string)
+}
+
+// FormatMessage renders the template of a message with the given parameters.
+//
+// Parameters:
+//   - key: The key of the message.
+//   - params: The parameters, in name/value pairs. (e.g., "actual", "x")
+//
+// Returns:
+//   - string: The rendered message.
+//
+// Behaviors:
+//   - If the key has no template, the key itself is used as the template.
+//   - Placeholders without a parameter are left as is.
+//   - A trailing name without a value is ignored.
+func FormatMessage(key MessageKey, params ...string) string {
+	overrides_mu.RLock()
+	template, ok := overrides[key]
+	overrides_mu.RUnlock()
+
+	if !ok {
+		template, ok = default_messages[key]
+		if !ok {
+			template = string(key)
+		}
+	}
+
+	if len(params) < 2 {
+		return template
+	}
+
+	pairs := make([]string, 0, len(params))
+
+	for i := 0; i+1 < len(params); i += 2 {
+		pairs = append(pairs, "{"+params[i]+"}", params[i+1])
+	}
+
+	r := strings.NewReplacer(pairs...)
+
+	return r.Replace(template)
+}
+
+// sentinel is an error whose message is taken from the catalog.
+type sentinel struct {
+	// key is the key of the message.
+	key MessageKey
+}
+
+// Error implements the error interface.
+func (e *sentinel) Error() string {
+	return FormatMessage(e.key)
+}
+
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - MessageKey: The key of the message.
+func (e *sentinel) MessageKey() MessageKey {
+	return e.key
+}
@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestDefaultMessages(t *testing.T) {
+	catalog := DefaultMessages()
+	catalog[KeyFull] = "changed"
+
+	if got := FormatMessage(KeyFull); got != "container is full" {
+		t.Errorf("DefaultMessages failed: expected %q, got %q", "container is full", got)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			SetMessage(KeyFull, "no room left")
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_ = ErrFull.Error()
+		}()
+	}
+
+	wg.Wait()
+
+	if got := ErrFull.Error(); got != "no room left" {
+		t.Errorf("SetMessage failed: expected %q, got %q", "no room left", got)
+	}
+
+	ResetMessages()
+
+	if got := ErrFull.Error(); got != "container is full" {
+		t.Errorf("ResetMessages failed: expected %q, got %q", "container is full", got)
+	}
+}
+
+func TestErrUnexpectedMessage(t *testing.T) {
+	// Unlike before the message catalog, there is no space before ", got".
+	tests := []struct {
+		err      *ErrUnexpected
+		expected string
+	}{
+		{NewErrUnexpected("x", "a"), `expected "a", got "x" instead`},
+		{NewErrUnexpected("", "a", "b"), `expected either "a" or "b", got nothing instead`},
+		{NewErrUnexpected("x"), `expected nothing, got "x" instead`},
+	}
+
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.expected {
+			t.Errorf("Error failed: expected %q, got %q", test.expected, got)
+		}
+	}
+
+	SetMessage(KeyUnexpected, "{actual} instead of {expected}")
+	defer ResetMessages()
+
+	if got := NewErrUnexpected("x", "a").Error(); got != `"x" instead of "a"` {
+		t.Errorf("SetMessage failed: expected %q, got %q", `"x" instead of "a"`, got)
+	}
+}
@@ -0,0 +1,183 @@
+package errors
+
+import (
+	"math"
+	"strconv"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+// ErrOutOfBound represents an error that occurs when a value is not within
+// the expected range.
+//
+// A bound set to math.MinInt (lower) or math.MaxInt (upper) means that the
+// range is unbounded on that side.
+type ErrOutOfBound struct {
+	// Value is the value that is out of bounds.
+	Value int
+
+	// LowerBound is the lower bound of the range.
+	LowerBound int
+
+	// UpperBound is the upper bound of the range.
+	UpperBound int
+
+	// LowerInclusive is true if the lower bound is part of the range.
+	LowerInclusive bool
+
+	// UpperInclusive is true if the upper bound is part of the range.
+	UpperInclusive bool
+}
+
+// Error implements the error interface.
+//
+// Message: "value <value> is out of bounds <range>"
+//
+// The range is written with square brackets for inclusive bounds and
+// parentheses for exclusive ones (e.g., "[0, 5)"). Unbounded sides are
+// written as "-inf" or "+inf".
+//
+// The message can be changed with SetMessage(KeyOutOfBound, ...).
+func (e *ErrOutOfBound) Error() string {
+	return FormatMessage(
+		KeyOutOfBound,
+		"value", strconv.Itoa(e.Value),
+		"range", e.Range(),
+	)
+}
+
+// MessageKey returns the key of the message of the error.
+//
+// Returns:
+//   - MessageKey: The key of the message.
+func (e *ErrOutOfBound) MessageKey() MessageKey {
+	return KeyOutOfBound
+}
+
+// Is checks whether the error matches the target.
+//
+// Parameters:
+//   - target: The target error.
+//
+// Returns:
+//   - bool: True if target is ErrOutOfRange, or if it is an *ErrOutOfBound
+//     or an *common.ErrOutOfBounds with the same value, bounds and
+//     inclusivity; false otherwise.
+//
+// Behaviors:
+//   - To match any out-of-bounds error regardless of its fields, use
+//     errors.Is(err, ErrOutOfRange) or errors.As.
+func (e *ErrOutOfBound) Is(target error) bool {
+	if target == ErrOutOfRange {
+		return true
+	}
+
+	switch t := target.(type) {
+	case *ErrOutOfBound:
+		return t != nil && *e == *t
+	case *uc.ErrOutOfBounds:
+		return t != nil &&
+			e.Value == t.Value &&
+			e.LowerBound == t.LowerBound &&
+			e.UpperBound == t.UpperBound &&
+			e.LowerInclusive == t.LowerInclusive &&
+			e.UpperInclusive == t.UpperInclusive
+	default:
+		return false
+	}
+}
+
+// Range returns the range of the error in interval notation.
+//
+// Returns:
+//   - string: The range. (e.g., "[0, 5)")
+func (e *ErrOutOfBound) Range() string {
+	var left, lower string
+
+	if e.LowerBound == math.MinInt {
+		left, lower = "(", "-inf"
+	} else {
+		lower = strconv.Itoa(e.LowerBound)
+
+		if e.LowerInclusive {
+			left = "["
+		} else {
+			left = "("
+		}
+	}
+
+	var right, upper string
+
+	if e.UpperBound == math.MaxInt {
+		right, upper = ")", "+inf"
+	} else {
+		upper = strconv.Itoa(e.UpperBound)
+
+		if e.UpperInclusive {
+			right = "]"
+		} else {
+			right = ")"
+		}
+	}
+
+	return left + lower + ", " + upper + right
+}
+
+// WithLowerBound sets whether the lower bound is inclusive.
+//
+// Parameters:
+//   - isInclusive: True if the lower bound is inclusive, false otherwise.
+//
+// Returns:
+//   - *ErrOutOfBound: The error itself, for chaining.
+func (e *ErrOutOfBound) WithLowerBound(isInclusive bool) *ErrOutOfBound {
+	e.LowerInclusive = isInclusive
+
+	return e
+}
+
+// WithUpperBound sets whether the upper bound is inclusive.
+//
+// Parameters:
+//   - isInclusive: True if the upper bound is inclusive, false otherwise.
+//
+// Returns:
+//   - *ErrOutOfBound: The error itself, for chaining.
+func (e *ErrOutOfBound) WithUpperBound(isInclusive bool) *ErrOutOfBound {
+	e.UpperInclusive = isInclusive
+
+	return e
+}
+
+// NewErrOutOfBound creates a new ErrOutOfBound error.
+//
+// Parameters:
+//   - value: The value that is out of bounds.
+//   - lowerBound: The lower bound of the range. Use math.MinInt for no
+//     lower bound.
+//   - upperBound: The upper bound of the range. Use math.MaxInt for no
+//     upper bound.
+//
+// Returns:
+//   - *ErrOutOfBound: A pointer to the new error.
+//
+// Behaviors:
+//   - By default, the lower bound is inclusive and the upper bound is
+//     exclusive, as for indices; use WithLowerBound and WithUpperBound to
+//     change that.
+//
+// Example:
+//
+//	err := NewErrOutOfBound(7, 0, 5).WithUpperBound(true)
+//	fmt.Println(err) // value 7 is out of bounds [0, 5]
+func NewErrOutOfBound(value, lowerBound, upperBound int) *ErrOutOfBound {
+	e := &ErrOutOfBound{
+		Value:          value,
+		LowerBound:     lowerBound,
+		UpperBound:     upperBound,
+		LowerInclusive: true,
+		UpperInclusive: false,
+	}
+
+	return e
+}
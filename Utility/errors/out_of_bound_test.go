@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	uc "github.com/PlayerR9/lib_units/common"
+)
+
+func TestErrOutOfBoundError(t *testing.T) {
+	tests := []struct {
+		err      *ErrOutOfBound
+		expected string
+	}{
+		{NewErrOutOfBound(7, 0, 5), "value 7 is out of bounds [0, 5)"},
+		{NewErrOutOfBound(7, 0, 5).WithUpperBound(true), "value 7 is out of bounds [0, 5]"},
+		{NewErrOutOfBound(0, 0, math.MaxInt).WithLowerBound(false), "value 0 is out of bounds (0, +inf)"},
+		{NewErrOutOfBound(3, math.MinInt, 3), "value 3 is out of bounds (-inf, 3)"},
+	}
+
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.expected {
+			t.Errorf("Error failed: expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestErrOutOfBoundIs(t *testing.T) {
+	err := fmt.Errorf("index: %w", NewErrOutOfBound(7, 0, 5))
+
+	tests := []struct {
+		name     string
+		target   error
+		expected bool
+	}{
+		{"ErrOutOfRange", ErrOutOfRange, true},
+		{"same fields", NewErrOutOfBound(7, 0, 5), true},
+		{"other value", NewErrOutOfBound(8, 0, 5), false},
+		{"other inclusivity", NewErrOutOfBound(7, 0, 5).WithUpperBound(true), false},
+		{"lib_units, same fields", uc.NewErrOutOfBounds(7, 0, 5), true},
+		{"lib_units, other bounds", uc.NewErrOutOfBounds(7, 1, 5), false},
+		{"other error", ErrNotFound, false},
+	}
+
+	for _, test := range tests {
+		if got := errors.Is(err, test.target); got != test.expected {
+			t.Errorf("Is failed (%s): expected %t, got %t", test.name, test.expected, got)
+		}
+	}
+
+	var target *ErrOutOfBound
+
+	if !errors.As(err, &target) || target.Value != 7 {
+		t.Errorf("errors.As failed: expected the *ErrOutOfBound, got %v", target)
+	}
+}
@@ -0,0 +1,23 @@
+package errors
+
+var (
+	// ErrNotFound is the error returned when an element, a key or a position
+	// could not be found.
+	ErrNotFound error = &sentinel{key: KeyNotFound}
+
+	// ErrFull is the error returned when a container has reached its
+	// capacity and cannot accept more elements.
+	ErrFull error = &sentinel{key: KeyFull}
+
+	// ErrEmptyInput is the error returned when an operation requires a
+	// non-empty input but an empty one was given.
+	ErrEmptyInput error = &sentinel{key: KeyEmptyInput}
+
+	// ErrTooLong is the error returned when an input is longer than what
+	// the operation can handle.
+	ErrTooLong error = &sentinel{key: KeyTooLong}
+
+	// ErrOutOfRange is the error returned when a value is not within the
+	// expected range. Every *ErrOutOfBound matches it with errors.Is.
+	ErrOutOfRange error = &sentinel{key: KeyOutOfRange}
+)
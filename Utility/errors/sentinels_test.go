@@ -0,0 +1,43 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSentinels(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrFull, ErrEmptyInput, ErrTooLong, ErrOutOfRange}
+
+	for i, target := range sentinels {
+		err := fmt.Errorf("context: %w", target)
+
+		for j, other := range sentinels {
+			if got := errors.Is(err, other); got != (i == j) {
+				t.Errorf("errors.Is(%v, %v) failed: expected %t, got %t", err, other, i == j, got)
+			}
+		}
+	}
+
+	err := fmt.Errorf("index: %w", NewErrOutOfBound(5, 0, 3))
+
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("errors.Is failed: expected %v to match %v", err, ErrOutOfRange)
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is failed: expected %v not to match %v", err, ErrNotFound)
+	}
+
+	// The sentinels stay the same values when their message changes.
+	SetMessage(KeyNotFound, "introuvable")
+	defer ResetMessages()
+
+	if got := ErrNotFound.Error(); got != "introuvable" {
+		t.Errorf("SetMessage failed: expected %q, got %q", "introuvable", got)
+	}
+
+	if !errors.Is(fmt.Errorf("%w", ErrNotFound), ErrNotFound) {
+		t.Errorf("errors.Is failed: expected a match after SetMessage")
+	}
+}